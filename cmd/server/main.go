@@ -34,30 +34,60 @@ func main() {
 	dumpDB := persistence.NewCache(db, "dump")
 	retraceDB := persistence.NewCache(db, "retrace")
 	configDB := persistence.NewCache(db, "config")
+	toolchainsDB := persistence.NewCache(db, "toolchains")
+	searchDB := persistence.NewCache(db, "search")
+	shadersDB := persistence.NewCache(db, "shaders")
 
 	router := httprouter.New()
 
 	router.GET("/apps", endpoints.GetApps(appsDB))
-	router.POST("/apps/:name", endpoints.AddApp(appsDB))
+	router.POST("/apps/:name", endpoints.AddApp(appsDB, toolchainsDB))
 	router.GET("/apps/:name", endpoints.GetApp(appsDB))
-	router.PUT("/apps/:name", endpoints.UpdateApp(appsDB))
+	router.PUT("/apps/:name", endpoints.UpdateApp(appsDB, toolchainsDB))
 	router.DELETE("/apps/:name", endpoints.DeleteApp(appsDB))
 
 	router.GET("/traces", endpoints.GetTraces(traceDB))
-	router.POST("/traces/:name", endpoints.AddTrace(traceDB, appsDB, dumpDB))
+	router.POST("/traces/:name", endpoints.AddTrace(traceDB, appsDB, dumpDB, searchDB, shadersDB, toolchainsDB, configDB))
 	router.GET("/traces/:name", endpoints.GetTrace(traceDB))
 	router.DELETE("/traces/:name", endpoints.DeleteTrace(traceDB))
+	router.GET("/traces/:name/frames", endpoints.GetFrameIndex(traceDB))
+	router.GET("/traces/:name/search", endpoints.SearchTrace(traceDB, dumpDB, searchDB))
+	router.GET("/traces/:name/stats", endpoints.GetTraceStats(traceDB, dumpDB))
+	router.GET("/traces/:name/stats/:frame", endpoints.GetFrameStats(dumpDB))
+	router.GET("/traces/:name/shaders", endpoints.GetTraceShaders(traceDB, shadersDB))
+	router.GET("/traces/:name/shaders/validation", endpoints.GetShaderValidations(traceDB, shadersDB))
+	router.GET("/traces/:name/memory", endpoints.GetTraceMemory(traceDB, dumpDB))
+	router.GET("/traces/:name/portability", endpoints.GetTracePortability(traceDB, dumpDB))
+	router.GET("/traces/:name/lint", endpoints.GetTraceLint(traceDB, dumpDB))
+	router.GET("/traces/:name/leaks", endpoints.GetTraceLeaks(traceDB, dumpDB))
+	router.GET("/traces/:name/diagnostics", endpoints.GetTraceDiagnostics(traceDB, dumpDB))
+	router.GET("/traces/:name/state/:call", endpoints.GetCallState(traceDB, dumpDB))
+	router.GET("/traces/:name/calls/:call", endpoints.GetCall(traceDB))
+	router.GET("/traces/:name/calls/:call/blobs/:arg", endpoints.GetBlob(traceDB))
+
+	router.GET("/shaders/:id", endpoints.GetShader(shadersDB))
+
+	router.GET("/compare/:traceA/:traceB", endpoints.CompareTraces(traceDB, dumpDB))
+	router.GET("/compare/:traceA/:traceB/shaders", endpoints.CompareShaders(traceDB, shadersDB))
+
+	router.GET("/search/functions/:fn", endpoints.SearchFunction(searchDB))
+	router.GET("/search/extensions/:ext", endpoints.SearchExtension(searchDB))
 
 	router.GET("/dumps/:name/:frame", endpoints.GetDump(dumpDB))
+	router.GET("/dumps/:name/:frame/tree", endpoints.GetDumpTree(dumpDB))
 	router.DELETE("/dumps/:name", endpoints.DeleteDump(dumpDB, traceDB))
 
-	router.POST("/retrace/:name/:call", endpoints.AddRetrace(retraceDB, traceDB, appsDB))
+	router.POST("/retrace/:name/:call", endpoints.AddRetrace(retraceDB, traceDB, dumpDB, appsDB, shadersDB, toolchainsDB, configDB))
 	router.GET("/retrace/:name/:call", endpoints.GetRetrace(retraceDB))
 
 	router.GET("/images/:name/:image", endpoints.GetImage(traceDB))
 
 	router.GET("/config", endpoints.GetConfig(configDB))
-	router.PUT("/config", endpoints.UpdateConfig(configDB))
+	router.PUT("/config", endpoints.UpdateConfig(configDB, toolchainsDB))
+
+	router.GET("/toolchains", endpoints.GetToolchains(toolchainsDB))
+	router.POST("/toolchains", endpoints.AddToolchain(toolchainsDB))
+	router.GET("/toolchains/:name", endpoints.GetToolchain(toolchainsDB))
 
 	router.POST("/killmenow", endpoints.Kill)
 	router.GET("/status", endpoints.Status)
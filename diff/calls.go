@@ -0,0 +1,213 @@
+package diff
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"strings"
+)
+
+// the most edits searched for when aligning the calls of a frame before pairing them up by position instead
+const maxCallEdits = 2000
+
+const (
+	Unchanged = "unchanged"
+	Inserted  = "inserted"
+	Deleted   = "deleted"
+	Changed   = "changed"
+)
+
+// One step in the alignment of two frames. Inserted calls only have After, deleted calls only have Before, and changed
+// calls list the arguments that differ
+type CallChange struct {
+	Kind   string            `json:"kind"`
+	Before *parsers.Call     `json:"before,omitempty"`
+	After  *parsers.Call     `json:"after,omitempty"`
+	Args   []*ArgumentChange `json:"args,omitempty"`
+}
+
+// An argument, or the return value, that differs between two aligned calls
+type ArgumentChange struct {
+	Index  int    `json:"index"`
+	Name   string `json:"name"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// The alignment of the calls of a frame in two traces. Approximate is set when the frames were too far apart to align
+// exactly, and their calls were paired up by position instead
+type FrameComparison struct {
+	Frame       int           `json:"frame"`
+	CallsA      int           `json:"callsA"`
+	CallsB      int           `json:"callsB"`
+	Inserted    int           `json:"inserted"`
+	Deleted     int           `json:"deleted"`
+	Changed     int           `json:"changed"`
+	Unchanged   int           `json:"unchanged"`
+	Approximate bool          `json:"approximate,omitempty"`
+	Calls       []*CallChange `json:"calls,omitempty"`
+}
+
+// Align the calls of a frame in two traces on their function names and the enums they pass, e.g. glEnable(GL_BLEND)
+// and glEnable(GL_DEPTH_TEST) never line up, then compare the arguments of the calls that do
+func Calls(frame int, a, b []*parsers.Call) *FrameComparison {
+	comparison := &FrameComparison{Frame: frame, CallsA: len(a), CallsB: len(b), Calls: []*CallChange{}}
+
+	keysA, keysB := callKeys(a), callKeys(b)
+
+	edits, ok := Edits(keysA, keysB, maxCallEdits)
+
+	if !ok {
+		comparison.Approximate = true
+		edits = byPosition(keysA, keysB)
+	}
+
+	for _, e := range edits {
+		var change *CallChange
+
+		switch e.Op {
+		case Keep:
+			change = &CallChange{Kind: Unchanged, Before: a[e.A], After: b[e.B], Args: arguments(a[e.A], b[e.B])}
+
+			if len(change.Args) > 0 {
+				change.Kind = Changed
+			}
+		case Delete:
+			change = &CallChange{Kind: Deleted, Before: a[e.A]}
+		case Insert:
+			change = &CallChange{Kind: Inserted, After: b[e.B]}
+		}
+
+		switch change.Kind {
+		case Unchanged:
+			comparison.Unchanged++
+		case Changed:
+			comparison.Changed++
+		case Deleted:
+			comparison.Deleted++
+		case Inserted:
+			comparison.Inserted++
+		}
+
+		comparison.Calls = append(comparison.Calls, change)
+	}
+
+	return comparison
+}
+
+func callKeys(calls []*parsers.Call) []string {
+	keys := make([]string, len(calls))
+
+	for i, call := range calls {
+		key := []string{call.FunctionName}
+
+		for _, arg := range call.Args {
+			switch arg.Value.Kind {
+			case parsers.EnumValue:
+				key = append(key, arg.Value.Symbol)
+			case parsers.BitmaskValue:
+				key = append(key, strings.Join(arg.Value.Symbols, "|"))
+			}
+		}
+
+		keys[i] = strings.Join(key, " ")
+	}
+
+	return keys
+}
+
+// pair the calls at the same position, keeping those with the same key and replacing the rest
+func byPosition(a, b []string) []Edit {
+	var edits []Edit
+
+	for i := 0; i < len(a) || i < len(b); i++ {
+		switch {
+		case i >= len(b):
+			edits = append(edits, Edit{Delete, i, len(b)})
+		case i >= len(a):
+			edits = append(edits, Edit{Insert, len(a), i})
+		case a[i] == b[i]:
+			edits = append(edits, Edit{Keep, i, i})
+		default:
+			edits = append(edits, Edit{Delete, i, i}, Edit{Insert, i + 1, i})
+		}
+	}
+
+	return edits
+}
+
+// the arguments and return values of two aligned calls that differ
+func arguments(a, b *parsers.Call) []*ArgumentChange {
+	var changes []*ArgumentChange
+
+	for i := 0; i < len(a.Args) || i < len(b.Args); i++ {
+		change := &ArgumentChange{Index: i}
+
+		if i < len(a.Args) {
+			change.Name = a.Args[i].Name
+			change.Before = comparedText(a.Args[i].Value)
+		}
+
+		if i < len(b.Args) {
+			change.Name = b.Args[i].Name
+			change.After = comparedText(b.Args[i].Value)
+		}
+
+		if len(change.Name) == 0 {
+			change.Name = fmt.Sprintf("arg%d", i)
+		}
+
+		if change.Before != change.After {
+			changes = append(changes, change)
+		}
+	}
+
+	before, after := comparedText(a.Return), comparedText(b.Return)
+
+	if before != after {
+		changes = append(changes, &ArgumentChange{Index: -1, Name: "return", Before: before, After: after})
+	}
+
+	return changes
+}
+
+// A value as it is compared between traces. Pointers only differ in whether they are null, as addresses change from
+// run to run, and blobs only in their size
+func comparedText(v *parsers.Value) string {
+	if v == nil {
+		return ""
+	}
+
+	switch v.Kind {
+	case parsers.PointerValue:
+		if v.Uint != nil && *v.Uint == 0 {
+			return "NULL"
+		}
+
+		return "pointer"
+
+	case parsers.BlobValue:
+		if v.Size != nil {
+			return fmt.Sprintf("blob(%d)", *v.Size)
+		}
+
+	case parsers.ArrayValue:
+		elements := make([]string, len(v.Elements))
+
+		for i, element := range v.Elements {
+			elements[i] = comparedText(element)
+		}
+
+		return "{" + strings.Join(elements, ", ") + "}"
+
+	case parsers.StructValue:
+		members := make([]string, len(v.Members))
+
+		for i, member := range v.Members {
+			members[i] = member.Name + " = " + comparedText(member.Value)
+		}
+
+		return "{" + strings.Join(members, ", ") + "}"
+	}
+
+	return v.Text
+}
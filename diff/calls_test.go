@@ -0,0 +1,186 @@
+package diff
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCalls(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+
+		// each step as its kind and the function of the call, and the arguments changed as name: before -> after
+		changes []string
+	}{
+		{
+			name: "enums align calls",
+			a: `0 glEnable(cap = GL_BLEND)
+1 glClear(mask = GL_COLOR_BUFFER_BIT)
+2 glDrawArrays(mode = GL_TRIANGLES, first = 0, count = 3)`,
+			b: `0 glEnable(cap = GL_DEPTH_TEST)
+1 glClear(mask = GL_COLOR_BUFFER_BIT | GL_DEPTH_BUFFER_BIT)
+2 glDrawArrays(mode = GL_TRIANGLES, first = 0, count = 6)`,
+			changes: []string{
+				"deleted glEnable",
+				"deleted glClear",
+				"inserted glEnable",
+				"inserted glClear",
+				"changed glDrawArrays count: 3 -> 6",
+			},
+		},
+		{
+			name: "calls inserted and deleted",
+			a: `0 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+1 glGenerateMipmap(target = GL_TEXTURE_2D)
+2 glFlush()`,
+			b: `0 glActiveTexture(texture = GL_TEXTURE0)
+1 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+2 glFlush()`,
+			changes: []string{
+				"inserted glActiveTexture",
+				"unchanged glBindTexture",
+				"deleted glGenerateMipmap",
+				"unchanged glFlush",
+			},
+		},
+		{
+			name: "pointers only differ when null",
+			a: `0 glXMakeCurrent(dpy = 0x5581ac2f06b0, drawable = 62914562, ctx = 0x5581ac3d1e40)
+1 glXMakeCurrent(dpy = 0x5581ac2f06b0, drawable = 62914562, ctx = 0x5581ac3d1e40)`,
+			b: `0 glXMakeCurrent(dpy = 0x55d0b4a1c2a0, drawable = 62914562, ctx = 0x55d0b4b8e010)
+1 glXMakeCurrent(dpy = 0x55d0b4a1c2a0, drawable = 62914562, ctx = NULL)`,
+			changes: []string{
+				"unchanged glXMakeCurrent",
+				"changed glXMakeCurrent ctx: pointer -> NULL",
+			},
+		},
+		{
+			name: "blobs only differ in size",
+			a: `0 glBufferData(target = GL_ARRAY_BUFFER, size = 16, data = blob(16), usage = GL_STATIC_DRAW)
+1 glBufferData(target = GL_ARRAY_BUFFER, size = 16, data = blob(16), usage = GL_STATIC_DRAW)`,
+			b: `0 glBufferData(target = GL_ARRAY_BUFFER, size = 16, data = blob(16), usage = GL_STATIC_DRAW)
+1 glBufferData(target = GL_ARRAY_BUFFER, size = 32, data = blob(32), usage = GL_STATIC_DRAW)`,
+			changes: []string{
+				"unchanged glBufferData",
+				"changed glBufferData size: 16 -> 32, data: blob(16) -> blob(32)",
+			},
+		},
+		{
+			name: "return values and structs",
+			a: `0 glGetError() = GL_NO_ERROR
+1 glDrawElementsIndirect(mode = GL_TRIANGLES, type = GL_UNSIGNED_INT, indirect = &{count = 36, instanceCount = 1})`,
+			b: `0 glGetError() = GL_INVALID_ENUM
+1 glDrawElementsIndirect(mode = GL_TRIANGLES, type = GL_UNSIGNED_INT, indirect = &{count = 36, instanceCount = 4})`,
+			changes: []string{
+				"changed glGetError return: GL_NO_ERROR -> GL_INVALID_ENUM",
+				"changed glDrawElementsIndirect indirect: {{count = 36, instanceCount = 1}} -> {{count = 36, instanceCount = 4}}",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			comparison := Calls(0, calls(t, test.a), calls(t, test.b))
+
+			if comparison.Approximate {
+				t.Error("expected the calls to be aligned exactly")
+			}
+
+			if changes := describe(comparison); !reflect.DeepEqual(changes, test.changes) {
+				t.Errorf("expected\n%s\ngot\n%s", strings.Join(test.changes, "\n"), strings.Join(changes, "\n"))
+			}
+
+			counted := comparison.Unchanged + comparison.Changed + comparison.Deleted + comparison.Inserted
+
+			if counted != len(comparison.Calls) {
+				t.Errorf("counted %d steps, but there are %d", counted, len(comparison.Calls))
+			}
+		})
+	}
+}
+
+// Frames too far apart to align within maxCallEdits are paired up by position
+func TestCallsByPosition(t *testing.T) {
+	var a, b strings.Builder
+
+	// every other call differs, which needs more edits than are searched for, and b has two more calls
+	for i := 0; i < maxCallEdits+2; i++ {
+		fmt.Fprintf(&a, "%d glFlush()\n", i)
+
+		if i%2 == 0 {
+			fmt.Fprintf(&b, "%d glFlush()\n", i)
+		} else {
+			fmt.Fprintf(&b, "%d glFinish()\n", i)
+		}
+	}
+
+	fmt.Fprintf(&b, "%d glFlush()\n%d glFinish()\n", maxCallEdits+2, maxCallEdits+3)
+
+	comparison := Calls(0, calls(t, a.String()), calls(t, b.String()))
+
+	if !comparison.Approximate {
+		t.Error("expected the calls to be paired up by position")
+	}
+
+	half := (maxCallEdits + 2) / 2
+
+	if comparison.Unchanged != half || comparison.Deleted != half || comparison.Inserted != half+2 {
+		t.Errorf("expected %d calls unchanged, %d deleted and %d inserted, got %d, %d and %d", half, half, half+2,
+			comparison.Unchanged, comparison.Deleted, comparison.Inserted)
+	}
+
+	want := []string{"unchanged glFlush", "deleted glFlush", "inserted glFinish", "unchanged glFlush"}
+
+	if changes := describe(comparison)[:4]; !reflect.DeepEqual(changes, want) {
+		t.Errorf("expected the comparison to start with %v, got %v", want, changes)
+	}
+
+	want = []string{"inserted glFlush", "inserted glFinish"}
+
+	if changes := describe(comparison); !reflect.DeepEqual(changes[len(changes)-2:], want) {
+		t.Errorf("expected the comparison to end with %v, got %v", want, changes[len(changes)-2:])
+	}
+}
+
+func calls(t *testing.T, dump string) []*parsers.Call {
+	frames := parsers.ParseDump(dump).Frames
+
+	if len(frames) != 1 {
+		t.Fatalf("expected one frame, got %d", len(frames))
+	}
+
+	return frames[0].Calls
+}
+
+func describe(comparison *FrameComparison) []string {
+	changes := []string{}
+
+	for _, change := range comparison.Calls {
+		call := change.Before
+
+		if call == nil {
+			call = change.After
+		}
+
+		text := change.Kind + " " + call.FunctionName
+
+		args := make([]string, len(change.Args))
+
+		for i, arg := range change.Args {
+			args[i] = fmt.Sprintf("%s: %s -> %s", arg.Name, arg.Before, arg.After)
+		}
+
+		if len(args) > 0 {
+			text += " " + strings.Join(args, ", ")
+		}
+
+		changes = append(changes, text)
+	}
+
+	return changes
+}
@@ -0,0 +1,155 @@
+// Package diff aligns sequences, such as the lines of two shaders or the calls of two frames, by the shortest edit
+// script between them
+package diff
+
+type Op int
+
+const (
+	Keep Op = iota
+	Delete
+	Insert
+)
+
+// One step of an edit script. A and B are the positions in each sequence the step was taken at, so a deletion is of
+// a[A] and an insertion is of b[B]
+type Edit struct {
+	Op Op
+	A  int
+	B  int
+}
+
+// The shortest edit script from a to b, by Myers' algorithm. The prefix and suffix the sequences share are kept
+// without being searched. When maxEdits is above zero and more edits than that are needed, ok is false and no script
+// is returned, as the search takes memory in proportion to the square of the number of edits
+func Edits(a, b []string, maxEdits int) (edits []Edit, ok bool) {
+	prefix := 0
+
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	for i := 0; i < prefix; i++ {
+		edits = append(edits, Edit{Keep, i, i})
+	}
+
+	middle, ok := myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix], maxEdits)
+
+	if !ok {
+		return nil, false
+	}
+
+	for _, e := range middle {
+		edits = append(edits, Edit{e.Op, e.A + prefix, e.B + prefix})
+	}
+
+	for i := 0; i < suffix; i++ {
+		edits = append(edits, Edit{Keep, len(a) - suffix + i, len(b) - suffix + i})
+	}
+
+	return edits, true
+}
+
+func myers(a, b []string, maxEdits int) ([]Edit, bool) {
+	n, m := len(a), len(b)
+	max := n + m
+
+	if maxEdits > 0 && maxEdits < max {
+		max = maxEdits
+	}
+
+	offset := max + 1
+
+	v := make([]int, 2*max+3)
+
+	// the furthest points reached after each number of edits, only as wide as that number of edits can reach
+	var trace [][]int
+
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int{}, v[offset-d:offset+d+1]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, n, m), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// walk back through the furthest points reached for each number of edits, from the end of both sequences to the start
+func backtrack(trace [][]int, n, m int) []Edit {
+	var edits []Edit
+
+	x, y := n, m
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		// trace[d] holds diagonals -d to d
+		at := func(k int) int {
+			return trace[d][k+d]
+		}
+
+		k := x - y
+
+		var previous int
+
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			previous = k + 1
+		} else {
+			previous = k - 1
+		}
+
+		var previousX int
+
+		if previous >= -d && previous <= d {
+			previousX = at(previous)
+		}
+
+		previousY := previousX - previous
+
+		for x > previousX && y > previousY {
+			x--
+			y--
+			edits = append(edits, Edit{Keep, x, y})
+		}
+
+		if d > 0 {
+			if x == previousX {
+				y--
+				edits = append(edits, Edit{Insert, x, y})
+			} else {
+				x--
+				edits = append(edits, Edit{Delete, x, y})
+			}
+		}
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+
+	return edits
+}
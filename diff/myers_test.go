@@ -0,0 +1,125 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEdits(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		maxEdits int
+
+		// the script, as the elements kept, deleted with - and inserted with +, when only one is shortest
+		script string
+		edits  int
+		ok     bool
+	}{
+		{name: "same", a: "a b c", b: "a b c", script: "a b c", ok: true},
+		{name: "both empty", ok: true},
+		{name: "all inserted", b: "a b", script: "+a +b", edits: 2, ok: true},
+		{name: "all deleted", a: "a b", script: "-a -b", edits: 2, ok: true},
+		{name: "replaced", a: "a b c", b: "a x c", script: "a -b +x c", edits: 2, ok: true},
+		{name: "inserted in the middle", a: "a c", b: "a b c", script: "a +b c", edits: 1, ok: true},
+		{name: "deleted from the end", a: "a b c d", b: "a b", script: "a b -c -d", edits: 2, ok: true},
+
+		// Myers' own example, which has several shortest scripts, found by backtracking past the shared prefix
+		{name: "backtracked", a: "a b c a b b a", b: "c b a b a c", edits: 5, ok: true},
+		{name: "backtracked without a shared prefix", a: "x a b c a b b a y", b: "z c b a b a c y", edits: 7, ok: true},
+		{name: "nothing shared", a: "a b c", b: "x y z", edits: 6, ok: true},
+
+		{name: "within the limit", a: "a b c", b: "a x c", maxEdits: 2, script: "a -b +x c", edits: 2, ok: true},
+		{name: "over the limit", a: "a b c d", b: "w x y z", maxEdits: 7},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := strings.Fields(test.a), strings.Fields(test.b)
+
+			edits, ok := Edits(a, b, test.maxEdits)
+
+			if ok != test.ok {
+				t.Fatalf("expected ok to be %t, got %t", test.ok, ok)
+			}
+
+			if !ok {
+				if edits != nil {
+					t.Errorf("expected no script, got %v", edits)
+				}
+
+				return
+			}
+
+			replayed, count := replay(t, a, b, edits)
+
+			if !reflect.DeepEqual(replayed, b) && !(len(replayed) == 0 && len(b) == 0) {
+				t.Errorf("the script %v turns %v into %v rather than %v", edits, a, replayed, b)
+			}
+
+			if count != test.edits {
+				t.Errorf("expected %d edits, got %d in %s", test.edits, count, script(a, b, edits))
+			}
+
+			if len(test.script) > 0 && script(a, b, edits) != test.script {
+				t.Errorf("expected the script %s, got %s", test.script, script(a, b, edits))
+			}
+		})
+	}
+}
+
+// apply the edits to a, checking each is taken where the last one left off, and count the deletions and insertions
+func replay(t *testing.T, a, b []string, edits []Edit) ([]string, int) {
+	var result []string
+
+	x, y, count := 0, 0, 0
+
+	for _, e := range edits {
+		if e.A != x || e.B != y {
+			t.Fatalf("expected the edit %v to be at %d, %d", e, x, y)
+		}
+
+		switch e.Op {
+		case Keep:
+			if a[x] != b[y] {
+				t.Fatalf("the edit %v keeps %s in place of %s", e, a[x], b[y])
+			}
+
+			result = append(result, a[x])
+			x++
+			y++
+		case Delete:
+			x++
+			count++
+		case Insert:
+			result = append(result, b[y])
+			y++
+			count++
+		}
+	}
+
+	if x != len(a) || y != len(b) {
+		t.Fatalf("the script ends at %d, %d rather than %d, %d", x, y, len(a), len(b))
+	}
+
+	return result, count
+}
+
+func script(a, b []string, edits []Edit) string {
+	steps := make([]string, len(edits))
+
+	for i, e := range edits {
+		switch e.Op {
+		case Keep:
+			steps[i] = a[e.A]
+		case Delete:
+			steps[i] = "-" + a[e.A]
+		case Insert:
+			steps[i] = "+" + b[e.B]
+		}
+	}
+
+	return strings.Join(steps, " ")
+}
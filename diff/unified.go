@@ -0,0 +1,113 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// the lines of unchanged text shown around each change
+const diffContext = 3
+
+// A unified diff from one text to another, labelled with the names given, or the empty string if they are the same
+func Unified(nameA, nameB, a, b string) string {
+	linesA, linesB := splitLines(a), splitLines(b)
+
+	edits, _ := Edits(linesA, linesB, 0)
+
+	var out strings.Builder
+
+	for start := 0; start < len(edits); {
+		// find the next change, then extend the hunk until the changes are further apart than the context can join
+		for start < len(edits) && edits[start].Op == Keep {
+			start++
+		}
+
+		if start == len(edits) {
+			break
+		}
+
+		end := start
+
+		for i := start; i < len(edits); i++ {
+			if edits[i].Op != Keep {
+				end = i + 1
+			} else if i-end >= 2*diffContext {
+				break
+			}
+		}
+
+		first := start - diffContext
+
+		if first < 0 {
+			first = 0
+		}
+
+		last := end + diffContext
+
+		if last > len(edits) {
+			last = len(edits)
+		}
+
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- %s\n+++ %s\n", nameA, nameB)
+		}
+
+		writeHunk(&out, edits[first:last], linesA, linesB)
+
+		start = last
+	}
+
+	return out.String()
+}
+
+func writeHunk(out *strings.Builder, edits []Edit, linesA, linesB []string) {
+	startA, startB, countA, countB := edits[0].A, edits[0].B, 0, 0
+
+	for _, e := range edits {
+		if e.Op != Insert {
+			countA++
+		}
+
+		if e.Op != Delete {
+			countB++
+		}
+	}
+
+	// ranges are numbered from 1, and an empty range is written as the line before it
+	if countA > 0 {
+		startA++
+	}
+
+	if countB > 0 {
+		startB++
+	}
+
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(startA, countA), hunkRange(startB, countB))
+
+	for _, e := range edits {
+		switch e.Op {
+		case Keep:
+			out.WriteString(" " + linesA[e.A] + "\n")
+		case Delete:
+			out.WriteString("-" + linesA[e.A] + "\n")
+		case Insert:
+			out.WriteString("+" + linesB[e.B] + "\n")
+		}
+	}
+}
+
+func hunkRange(start, count int) string {
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+func splitLines(text string) []string {
+	if len(text) == 0 {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -3,6 +3,7 @@ package endpoints
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/fergloragain/apitrace-remote/operations"
 	"github.com/fergloragain/apitrace-remote/persistence"
 	"github.com/julienschmidt/httprouter"
 	"io"
@@ -26,6 +27,9 @@ type App struct {
 	Branch      string   `json:"branch"`
 	Traces      []string `json:"traces"`
 	DumpImages  bool     `json:"dumpImages"`
+	Toolchain   string   `json:"toolchain"`
+	API         string   `json:"api"`
+	TraceMode   string   `json:"traceMode"`
 }
 
 type NewAppRequest struct {
@@ -41,6 +45,9 @@ type NewAppRequest struct {
 	Branch      string `json:"branch"`
 	Timeout     int    `json:"timeout"`
 	DumpImages  bool   `json:"dumpImages"`
+	Toolchain   string `json:"toolchain"`
+	API         string `json:"api"`
+	TraceMode   string `json:"traceMode"`
 }
 
 type AppDescription struct {
@@ -105,7 +112,7 @@ Error: %s`, err.Error())))
 }
 
 // Add a new App to the DB
-func AddApp(appsDB *persistence.Cache) httprouter.Handle {
+func AddApp(appsDB, toolchainsDB *persistence.Cache) httprouter.Handle {
 
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		name := p.ByName("name")
@@ -146,6 +153,32 @@ Error: %s`, err.Error())))
 		branch := newAppRequest.Branch
 		timeout := newAppRequest.Timeout
 		dumpImages := newAppRequest.DumpImages
+		toolchain := newAppRequest.Toolchain
+		api := newAppRequest.API
+		traceMode := newAppRequest.TraceMode
+
+		if err := operations.ValidateAPI(api); err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`AddApp: invalid API
+Error: %s`, err.Error())))
+			return
+		}
+
+		if err := operations.ValidateTraceMode(traceMode, api); err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`AddApp: invalid trace mode
+Error: %s`, err.Error())))
+			return
+		}
+
+		if len(toolchain) > 0 {
+			if _, err := getToolchain(toolchainsDB, toolchain); err != nil {
+				w.WriteHeader(400)
+				w.Write([]byte(fmt.Sprintf(`AddApp: toolchain <%s> is not registered
+Error: %s`, toolchain, err.Error())))
+				return
+			}
+		}
 
 		newID := appsDB.GetValidID(name)
 
@@ -165,6 +198,9 @@ Error: %s`, err.Error())))
 			branch,
 			[]string{},
 			dumpImages,
+			toolchain,
+			api,
+			traceMode,
 		}
 
 		applicationJSON, err := json.Marshal(app)
@@ -200,7 +236,7 @@ Error: %s`, appName, err.Error())))
 }
 
 // Update a particular app in the DB
-func UpdateApp(appsDB *persistence.Cache) httprouter.Handle {
+func UpdateApp(appsDB, toolchainsDB *persistence.Cache) httprouter.Handle {
 
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		appName := p.ByName("name")
@@ -230,19 +266,19 @@ Error: %s`, appName, err.Error())))
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`UpdateApp: could not read request body
-Error: %s`, appName, err.Error())))
+Error: %s`, err.Error())))
 			return
 		}
 		if err := r.Body.Close(); err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`UpdateApp: could not close body reader
-Error: %s`, appName, err.Error())))
+Error: %s`, err.Error())))
 			return
 		}
 		if err := json.Unmarshal(body, &nar); err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`UpdateApp: could not unmarshal JSON body
-Error: %s`, appName, err.Error())))
+Error: %s`, err.Error())))
 			return
 		}
 
@@ -258,6 +294,32 @@ Error: %s`, appName, err.Error())))
 		timeout := nar.Timeout
 		name := nar.Name
 		dumpImages := nar.DumpImages
+		toolchain := nar.Toolchain
+		api := nar.API
+		traceMode := nar.TraceMode
+
+		if err := operations.ValidateAPI(api); err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`UpdateApp: invalid API
+Error: %s`, err.Error())))
+			return
+		}
+
+		if err := operations.ValidateTraceMode(traceMode, api); err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`UpdateApp: invalid trace mode
+Error: %s`, err.Error())))
+			return
+		}
+
+		if len(toolchain) > 0 {
+			if _, err := getToolchain(toolchainsDB, toolchain); err != nil {
+				w.WriteHeader(400)
+				w.Write([]byte(fmt.Sprintf(`UpdateApp: toolchain <%s> is not registered
+Error: %s`, toolchain, err.Error())))
+				return
+			}
+		}
 
 		updatedApplication := App{
 			appName,
@@ -275,6 +337,9 @@ Error: %s`, appName, err.Error())))
 			branch,
 			app.Traces,
 			dumpImages,
+			toolchain,
+			api,
+			traceMode,
 		}
 
 		appJSON, err := json.Marshal(updatedApplication)
@@ -282,7 +347,7 @@ Error: %s`, appName, err.Error())))
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`UpdateApp: could not marshal updated application JSON
-Error: %s`, appName, err.Error())))
+Error: %s`, err.Error())))
 		} else {
 			appsDB.Set(appName, appJSON)
 			w.Write(appJSON)
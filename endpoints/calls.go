@@ -0,0 +1,136 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/tracefile"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"strconv"
+)
+
+// List the frames of a trace and the calls each spans, read straight from the trace file
+func GetFrameIndex(traceDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		trace, err := getTrace(traceDB, traceName)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetFrameIndex: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		frames, err := tracefile.IndexFrames(trace.tracePath())
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetFrameIndex: could not read trace file <%s>
+Error: %s`, trace.tracePath(), err.Error())))
+			return
+		}
+
+		framesJSON, err := json.Marshal(frames)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetFrameIndex: could not marshal frames for trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(framesJSON)
+	}
+
+}
+
+// Look up a single call in a trace file, with its typed arguments
+func GetCall(traceDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+		callID := p.ByName("call")
+
+		trace, call, status, err := findCall(traceDB, traceName, callID)
+
+		if err != nil {
+			w.WriteHeader(status)
+			w.Write([]byte(fmt.Sprintf(`GetCall: could not find call <%s> in trace <%s>
+Error: %s`, callID, traceName, err.Error())))
+			return
+		}
+
+		callJSON, err := json.Marshal(call.ParsedCall())
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetCall: could not marshal call <%s> from <%s>
+Error: %s`, callID, trace.tracePath(), err.Error())))
+			return
+		}
+
+		w.Write(callJSON)
+	}
+
+}
+
+// Return the raw contents of a blob passed to a call, such as the data uploaded by glBufferData. The argument is given
+// by name or position
+func GetBlob(traceDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+		callID := p.ByName("call")
+		arg := p.ByName("arg")
+
+		_, call, status, err := findCall(traceDB, traceName, callID)
+
+		if err != nil {
+			w.WriteHeader(status)
+			w.Write([]byte(fmt.Sprintf(`GetBlob: could not find call <%s> in trace <%s>
+Error: %s`, callID, traceName, err.Error())))
+			return
+		}
+
+		blob, err := tracefile.FindBlob(call, arg)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetBlob: could not find blob <%s> of call <%s> in trace <%s>
+Error: %s`, arg, callID, traceName, err.Error())))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.Itoa(len(blob.Data)))
+		w.Write(blob.Data)
+	}
+
+}
+
+// returns the HTTP status to fail with alongside any error
+func findCall(traceDB *persistence.Cache, traceName, callID string) (*Trace, *tracefile.Call, int, error) {
+	trace, err := getTrace(traceDB, traceName)
+
+	if err != nil {
+		return nil, nil, 404, err
+	}
+
+	number, err := strconv.ParseUint(callID, 10, 64)
+
+	if err != nil {
+		return trace, nil, 400, err
+	}
+
+	call, err := tracefile.FindCall(trace.tracePath(), number)
+
+	if err != nil {
+		return trace, nil, 404, err
+	}
+
+	return trace, call, 200, nil
+}
@@ -0,0 +1,223 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/diff"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+// the number of frames summarised, and calls listed, when no limit is given
+const (
+	defaultComparedFrames = 20
+	defaultComparedCalls  = 500
+)
+
+// A page of the frames of two traces, each with the counts of calls inserted, deleted and changed between them
+type TraceComparison struct {
+	TraceA  string                  `json:"traceA"`
+	TraceB  string                  `json:"traceB"`
+	FramesA int                     `json:"framesA"`
+	FramesB int                     `json:"framesB"`
+	Frames  []*diff.FrameComparison `json:"frames"`
+	Offset  int                     `json:"offset"`
+	Limit   int                     `json:"limit"`
+	Total   int                     `json:"total"`
+	Next    *int                    `json:"next,omitempty"`
+}
+
+// A page of the aligned calls of one frame of two traces
+type FrameCallComparison struct {
+	*diff.FrameComparison
+	Offset int  `json:"offset"`
+	Limit  int  `json:"limit"`
+	Total  int  `json:"total"`
+	Next   *int `json:"next,omitempty"`
+}
+
+// Align the calls of two traces frame by frame. Without a frame, each frame is summarised by how many calls were
+// inserted, deleted and changed; with one, the differences between its calls are listed
+func CompareTraces(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceA := p.ByName("traceA")
+		traceB := p.ByName("traceB")
+
+		query := r.URL.Query()
+
+		traces := make([]*Trace, 2)
+
+		for i, traceName := range []string{traceA, traceB} {
+			trace, err := getTrace(traceDB, traceName)
+
+			if err != nil {
+				w.WriteHeader(404)
+				w.Write([]byte(fmt.Sprintf(`CompareTraces: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+				return
+			}
+
+			traces[i] = trace
+		}
+
+		frames := traces[0].NumberOfFrames
+
+		if traces[1].NumberOfFrames > frames {
+			frames = traces[1].NumberOfFrames
+		}
+
+		frame, err := intParameter(query, "frame", -1)
+
+		defaultLimit := defaultComparedFrames
+
+		if frame >= 0 {
+			defaultLimit = defaultComparedCalls
+		}
+
+		offset, limit := 0, 0
+
+		if err == nil {
+			offset, err = intParameter(query, "offset", 0)
+		}
+
+		if err == nil {
+			limit, err = intParameter(query, "limit", defaultLimit)
+		}
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`CompareTraces: invalid parameters comparing traces <%s> and <%s>
+Error: %s`, traceA, traceB, err.Error())))
+			return
+		}
+
+		if frame < 0 {
+			comparison := &TraceComparison{
+				TraceA:  traceA,
+				TraceB:  traceB,
+				FramesA: traces[0].NumberOfFrames,
+				FramesB: traces[1].NumberOfFrames,
+				Frames:  []*diff.FrameComparison{},
+				Offset:  offset,
+				Limit:   limit,
+				Total:   frames,
+			}
+
+			for frame := offset; frame < frames && (limit == 0 || frame < offset+limit); frame++ {
+				frameComparison, err := compareFrame(dumpDB, traces[0], traces[1], frame)
+
+				if err != nil {
+					w.WriteHeader(500)
+					w.Write([]byte(fmt.Sprintf(`CompareTraces: could not compare frame %d of traces <%s> and <%s>
+Error: %s`, frame, traceA, traceB, err.Error())))
+					return
+				}
+
+				frameComparison.Calls = nil
+
+				comparison.Frames = append(comparison.Frames, frameComparison)
+			}
+
+			if limit > 0 && offset+limit < frames {
+				next := offset + limit
+				comparison.Next = &next
+			}
+
+			comparisonJSON, err := json.Marshal(comparison)
+
+			if err != nil {
+				w.WriteHeader(500)
+				w.Write([]byte(fmt.Sprintf(`CompareTraces: could not marshal the comparison of traces <%s> and <%s>
+Error: %s`, traceA, traceB, err.Error())))
+				return
+			}
+
+			w.Write(comparisonJSON)
+			return
+		}
+
+		if frame >= frames {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`CompareTraces: neither trace <%s> nor <%s> has frame %d
+Error: they have %d and %d frames`, traceA, traceB, frame, traces[0].NumberOfFrames, traces[1].NumberOfFrames)))
+			return
+		}
+
+		frameComparison, err := compareFrame(dumpDB, traces[0], traces[1], frame)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`CompareTraces: could not compare frame %d of traces <%s> and <%s>
+Error: %s`, frame, traceA, traceB, err.Error())))
+			return
+		}
+
+		// calls that are the same in both traces are left out unless asked for
+		unchanged := query.Get("unchanged") == "true"
+
+		result := &FrameCallComparison{FrameComparison: frameComparison, Offset: offset, Limit: limit}
+
+		calls := []*diff.CallChange{}
+
+		for _, change := range frameComparison.Calls {
+			if change.Kind == diff.Unchanged && !unchanged {
+				continue
+			}
+
+			if result.Total >= offset && (limit == 0 || result.Total < offset+limit) {
+				calls = append(calls, change)
+			}
+
+			result.Total++
+		}
+
+		frameComparison.Calls = calls
+
+		if limit > 0 && offset+limit < result.Total {
+			next := offset + limit
+			result.Next = &next
+		}
+
+		resultJSON, err := json.Marshal(result)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`CompareTraces: could not marshal the comparison of frame %d of traces <%s> and <%s>
+Error: %s`, frame, traceA, traceB, err.Error())))
+			return
+		}
+
+		w.Write(resultJSON)
+	}
+
+}
+
+// align the calls of a frame in two traces, counting a frame only one of them reached as empty in the other
+func compareFrame(dumpDB *persistence.Cache, traceA, traceB *Trace, frame int) (*diff.FrameComparison, error) {
+	calls := make([][]*parsers.Call, 2)
+
+	for i, trace := range []*Trace{traceA, traceB} {
+		if frame >= trace.NumberOfFrames {
+			continue
+		}
+
+		stored, err := loadFrame(dumpDB, trace.ID, frame)
+
+		if err != nil {
+			return nil, err
+		}
+
+		loaded, err := stored.frame(dumpDB, trace.ID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		calls[i] = loaded.Calls
+	}
+
+	return diff.Calls(frame, calls[0], calls[1]), nil
+}
@@ -2,8 +2,11 @@ package endpoints
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/fergloragain/apitrace-remote/persistence"
 	"github.com/julienschmidt/httprouter"
+	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 )
@@ -21,6 +24,12 @@ type Config struct {
 	Branch      string `json:"branch"`
 	Timeout     int    `json:"timeout"`
 	DumpImages  bool   `json:"dumpImages"`
+
+	// the toolchain used by apps and jobs that do not name one
+	DefaultToolchain string `json:"defaultToolchain"`
+
+	// the glslangValidator binary captured shaders are checked with, found on the PATH when not given
+	ShaderValidator string `json:"shaderValidator"`
 }
 
 // Add a new App to the DB
@@ -43,39 +52,90 @@ func SetConfig(configDB *persistence.Cache, body []byte) {
 	configDB.Set("config", configJSON)
 }
 
-// Retrieve specific details about a particular app in the DB
+// Retrieve the server configuration
 func GetConfig(configDB *persistence.Cache) httprouter.Handle {
 
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
-		w.WriteHeader(501)
-		w.Write([]byte("GetConfig not implemented"))
+		configJSON, err := json.Marshal(getConfig(configDB))
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetConfig: could not marshal config
+Error: %s`, err.Error())))
+			return
+		}
+
+		w.Write(configJSON)
 	}
-	//val, err := configDB.Get("config")
-	//
-	//if err != nil {
-	//	w.Write([]byte(err.Error()))
-	//} else {
-	//	w.Write(val.([]byte))
-	//}
 }
 
-// Update a particular app in the DB
-func UpdateConfig(configDB *persistence.Cache) httprouter.Handle {
+// Update the server configuration
+func UpdateConfig(configDB, toolchainsDB *persistence.Cache) httprouter.Handle {
 
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
-		w.WriteHeader(501)
-		w.Write([]byte("UpdateConfig not implemented"))
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, 1048576))
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`UpdateConfig: could not read request body
+Error: %s`, err.Error())))
+			return
+		}
+
+		if err := r.Body.Close(); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`UpdateConfig: could not close body
+Error: %s`, err.Error())))
+			return
+		}
+
+		var c Config
+
+		if err := json.Unmarshal(body, &c); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`UpdateConfig: could not unmarshal request body
+Error: %s`, err.Error())))
+			return
+		}
+
+		if len(c.DefaultToolchain) > 0 {
+			if _, err := getToolchain(toolchainsDB, c.DefaultToolchain); err != nil {
+				w.WriteHeader(400)
+				w.Write([]byte(fmt.Sprintf(`UpdateConfig: default toolchain <%s> is not registered
+Error: %s`, c.DefaultToolchain, err.Error())))
+				return
+			}
+		}
+
+		SetConfig(configDB, body)
+
+		configJSON, err := json.Marshal(c)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`UpdateConfig: could not marshal config
+Error: %s`, err.Error())))
+			return
+		}
+
+		w.Write(configJSON)
+	}
+}
+
+// Load the stored server configuration, or an empty one if none has been saved yet
+func getConfig(configDB *persistence.Cache) Config {
+
+	var c Config
+
+	val, err := configDB.Get("config")
+
+	if err != nil {
+		return c
+	}
+
+	if err := json.Unmarshal(val.([]byte), &c); err != nil {
+		log.Print(err.Error())
 	}
 
-	//body, err := ioutil.ReadAll(io.LimitReader(r.Body, 1048576))
-	//if err != nil {
-	//	w.Write([]byte(err.Error()))
-	//	return
-	//}
-	//if err := r.Body.Close(); err != nil {
-	//	w.Write([]byte(err.Error()))
-	//	return
-	//}
-
-	//SetConfig(body)
+	return c
 }
@@ -0,0 +1,180 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Retraces of the same trace run concurrently, and each one updates the diagnostics of the trace
+var diagnosticsLock sync.Mutex
+
+// The diagnostics of every call of a trace that a retrace has replayed, in call order
+type TraceDiagnostics struct {
+	Diagnostics []*parsers.Diagnostic `json:"diagnostics"`
+}
+
+func diagnosticsID(traceID string) string {
+	return fmt.Sprintf("%s-diagnostics", traceID)
+}
+
+// Give each diagnostic the frame holding its call
+func diagnosticFrames(dumpDB *persistence.Cache, trace *Trace, diagnostics []*parsers.Diagnostic) {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Call == nil {
+			continue
+		}
+
+		if stored, err := findFrame(dumpDB, trace, *diagnostic.Call); err == nil {
+			frame := stored.ID
+			diagnostic.Frame = &frame
+		}
+	}
+}
+
+// Record the diagnostics of a retrace up to a call against the calls of its trace. The retrace replayed every call up
+// to and including that one, so its diagnostics replace whatever earlier retraces reported for them
+func recordDiagnostics(dumpDB *persistence.Cache, traceID string, call int, diagnostics []*parsers.Diagnostic) error {
+	diagnosticsLock.Lock()
+	defer diagnosticsLock.Unlock()
+
+	recorded, err := loadDiagnostics(dumpDB, traceID)
+
+	if err != nil {
+		return err
+	}
+
+	kept := []*parsers.Diagnostic{}
+
+	for _, diagnostic := range recorded.Diagnostics {
+		if *diagnostic.Call > call {
+			kept = append(kept, diagnostic)
+		}
+	}
+
+	// diagnostics that don't name a call can't be attached to one
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Call != nil {
+			kept = append(kept, diagnostic)
+		}
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool {
+		return *kept[i].Call < *kept[j].Call
+	})
+
+	recorded.Diagnostics = kept
+
+	recordedJSON, err := json.Marshal(recorded)
+
+	if err != nil {
+		return err
+	}
+
+	dumpDB.Set(diagnosticsID(traceID), recordedJSON)
+
+	return nil
+}
+
+// Read the diagnostics of a trace, or none if it has never been retraced
+func loadDiagnostics(dumpDB *persistence.Cache, traceID string) (*TraceDiagnostics, error) {
+	recorded := &TraceDiagnostics{Diagnostics: []*parsers.Diagnostic{}}
+
+	val, err := dumpDB.Get(diagnosticsID(traceID))
+
+	if err != nil {
+		return recorded, nil
+	}
+
+	if err := json.Unmarshal(val.([]byte), recorded); err != nil {
+		return nil, err
+	}
+
+	return recorded, nil
+}
+
+// Attach the diagnostics recorded for a trace to the calls they were reported against
+func attachDiagnostics(dumpDB *persistence.Cache, traceID string, calls []*parsers.Call) error {
+	recorded, err := loadDiagnostics(dumpDB, traceID)
+
+	if err != nil || len(recorded.Diagnostics) == 0 {
+		return err
+	}
+
+	byCall := map[int][]*parsers.Diagnostic{}
+
+	for _, diagnostic := range recorded.Diagnostics {
+		byCall[*diagnostic.Call] = append(byCall[*diagnostic.Call], diagnostic)
+	}
+
+	for _, call := range calls {
+		if id, err := strconv.Atoi(call.ID); err == nil {
+			call.Diagnostics = byCall[id]
+		}
+	}
+
+	return nil
+}
+
+// List what glretrace reported about the calls of a trace its retraces replayed, in call order, so the first GL error
+// of a kind can be jumped to. The list can be narrowed to a GL error with `error`, e.g. GL_INVALID_OPERATION, and to a
+// severity with `severity`
+func GetTraceDiagnostics(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceDiagnostics: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		recorded, err := loadDiagnostics(dumpDB, traceName)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceDiagnostics: could not read the diagnostics of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		query := r.URL.Query()
+
+		glError := query.Get("error")
+		severity := query.Get("severity")
+
+		result := &TraceDiagnostics{Diagnostics: []*parsers.Diagnostic{}}
+
+		for _, diagnostic := range recorded.Diagnostics {
+			if len(glError) > 0 && diagnostic.Error != glError {
+				continue
+			}
+
+			if len(severity) > 0 && diagnostic.Severity != severity {
+				continue
+			}
+
+			result.Diagnostics = append(result.Diagnostics, diagnostic)
+		}
+
+		diagnosticsJSON, err := json.Marshal(result)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceDiagnostics: could not marshal the diagnostics of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(diagnosticsJSON)
+	}
+
+}
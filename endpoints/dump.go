@@ -1,7 +1,9 @@
 package endpoints
 
 import (
+	"encoding/json"
 	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
 	"github.com/fergloragain/apitrace-remote/persistence"
 	"github.com/julienschmidt/httprouter"
 	"net/http"
@@ -24,21 +26,136 @@ Error: %s`, frameNumber, err.Error())))
 			return
 		}
 
-		dumpID := fmt.Sprintf("%s-%d", dumpName, fn)
+		dumpID := frameID(dumpName, fn)
 
-		val, err := dumpDB.Get(dumpID)
+		stored, err := loadFrame(dumpDB, dumpName, fn)
 
 		if err != nil {
 			w.WriteHeader(404)
 			w.Write([]byte(fmt.Sprintf(`GetDump: could not get dump for ID <%s>
 Error: %s`, dumpID, err.Error())))
-		} else {
-			w.Write(val.([]byte))
+			return
+		}
+
+		query := r.URL.Query()
+
+		filter, err := newCallFilter(query)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetDump: invalid filter for dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		offset, err := intParameter(query, "offset", 0)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetDump: invalid offset for dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		limit, err := intParameter(query, "limit", 0)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetDump: invalid limit for dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		page, err := stored.page(dumpDB, dumpName, filter, offset, limit)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetDump: could not read the calls of dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		if err := attachDiagnostics(dumpDB, dumpName, page.Calls); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetDump: could not read the diagnostics of dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		pageJSON, err := json.Marshal(page)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetDump: could not marshal dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		w.Write(pageJSON)
+	}
+
+}
+
+// Retrieve the call tree of a frame, nested by the debug groups and markers the application inserted
+func GetDumpTree(dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		dumpName := p.ByName("name")
+		frameNumber := p.ByName("frame")
+
+		fn, err := strconv.Atoi(frameNumber)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetDumpTree: could not convert <%s> to an int
+Error: %s`, frameNumber, err.Error())))
+			return
+		}
+
+		if tree, err := dumpDB.Get(treeID(dumpName, fn)); err == nil {
+			w.Write(tree.([]byte))
+			return
+		}
+
+		// traces dumped before trees were stored only have their frames, so build the tree from the frame alone
+		dumpID := frameID(dumpName, fn)
+
+		stored, err := loadFrame(dumpDB, dumpName, fn)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetDumpTree: could not get dump for ID <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		frame, err := stored.frame(dumpDB, dumpName)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetDumpTree: could not read the calls of dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
 		}
+
+		treeJSON, err := json.Marshal(parsers.BuildTree(frame))
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetDumpTree: could not marshal the call tree for dump <%s>
+Error: %s`, dumpID, err.Error())))
+			return
+		}
+
+		w.Write(treeJSON)
 	}
 
 }
 
+func treeID(traceID string, frame int) string {
+	return fmt.Sprintf("%s-%d-tree", traceID, frame)
+}
+
 // Delete a particular dump from the DB
 func DeleteDump(dumpDB, traceDB *persistence.Cache) httprouter.Handle {
 
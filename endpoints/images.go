@@ -40,12 +40,14 @@ Error: %s`, appName, err.Error())))
 			return
 		}
 
-		imageFile, err := os.Open(fmt.Sprintf("%s/%s", trace.TargetDirectory, imageID))
+		imagePath := fmt.Sprintf("%s/%s", trace.TargetDirectory, imageID)
+
+		imageFile, err := os.Open(imagePath)
 
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`GetImage: unable to open image <%s>
-Error: %s`, imageFile, err.Error())))
+Error: %s`, imagePath, err.Error())))
 			return
 		}
 
@@ -56,7 +58,7 @@ Error: %s`, imageFile, err.Error())))
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`GetImage: unable to decode image <%s>
-Error: %s`, imageFile, err.Error())))
+Error: %s`, imagePath, err.Error())))
 			return
 		}
 
@@ -64,7 +66,7 @@ Error: %s`, imageFile, err.Error())))
 		if err := png.Encode(buffer, im); err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`GetImage: unable to encode image as PNG <%s>
-Error: %s`, imageFile, err.Error())))
+Error: %s`, imagePath, err.Error())))
 			return
 		}
 
@@ -73,7 +75,7 @@ Error: %s`, imageFile, err.Error())))
 		if _, err := w.Write(buffer.Bytes()); err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`GetImage: unable to write image <%s>
-Error: %s`, imageFile, err.Error())))
+Error: %s`, imagePath, err.Error())))
 			return
 		}
 	}
@@ -0,0 +1,192 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/lint"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/portability"
+	"github.com/fergloragain/apitrace-remote/search"
+	"github.com/fergloragain/apitrace-remote/shaders"
+	"github.com/fergloragain/apitrace-remote/state"
+)
+
+// Everything worked out from a trace's frames as they are dumped. Each frame is stored and indexed as soon as it
+// arrives, so the dump is never held in memory as a whole
+type ingester struct {
+	traceID   string
+	appID     string
+	dumpDB    *persistence.Cache
+	searchDB  *persistence.Cache
+	shadersDB *persistence.Cache
+	validator string
+
+	glStrings   parsers.GLStrings
+	trees       *parsers.TreeBuilder
+	stats       *parsers.StatsBuilder
+	shaders     *shaders.CatalogueBuilder
+	state       *state.Tracker
+	lifetimes   *state.LifetimeTracker
+	memory      *state.MemoryTracker
+	linter      *lint.Linter
+	portability *portability.Checker
+	index       *search.IndexWriter
+	usage       *search.Usage
+}
+
+func newIngester(traceID, appID, validator string, dumpDB, searchDB, shadersDB *persistence.Cache) *ingester {
+	// the analyzers following the state of the trace share one tracker, which is advanced past each call once they
+	// have all seen it
+	tracker := state.NewTracker()
+
+	in := &ingester{
+		traceID:     traceID,
+		appID:       appID,
+		dumpDB:      dumpDB,
+		searchDB:    searchDB,
+		shadersDB:   shadersDB,
+		validator:   validator,
+		trees:       parsers.NewTreeBuilder(),
+		stats:       parsers.NewStatsBuilder(),
+		shaders:     shaders.NewCatalogueBuilder(),
+		state:       tracker,
+		lifetimes:   state.NewLifetimeTracker(tracker),
+		memory:      state.NewMemoryTracker(tracker),
+		linter:      lint.NewLinter(tracker),
+		portability: portability.NewChecker(),
+		usage:       search.NewUsage(),
+	}
+
+	in.index = search.NewIndexWriter(func(segment int, data []byte) error {
+		searchDB.Set(segmentID(traceID, segment), data)
+		return nil
+	})
+
+	return in
+}
+
+// Store and index a frame; this is the parsers.FrameHandler given to the backend's dump
+func (in *ingester) handleFrame(frame *parsers.Frame) error {
+	for _, call := range frame.Calls {
+		in.glStrings.Record(call)
+		in.usage.Add(call)
+	}
+
+	if err := storeFrame(in.dumpDB, in.traceID, frame); err != nil {
+		return fmt.Errorf("could not store frame %d: %s", frame.ID, err.Error())
+	}
+
+	tree, err := json.Marshal(in.trees.Build(frame))
+
+	if err != nil {
+		return fmt.Errorf("could not marshal the call tree of frame %d: %s", frame.ID, err.Error())
+	}
+
+	in.dumpDB.Set(treeID(in.traceID, frame.ID), tree)
+
+	stats, err := json.Marshal(in.stats.Add(frame))
+
+	if err != nil {
+		return fmt.Errorf("could not marshal the statistics of frame %d: %s", frame.ID, err.Error())
+	}
+
+	in.dumpDB.Set(frameStatsID(in.traceID, frame.ID), stats)
+
+	in.shaders.Add(frame)
+
+	if err := storeStateCheckpoint(in.dumpDB, in.traceID, frame.ID, in.state); err != nil {
+		return fmt.Errorf("could not store the state at the start of frame %d: %s", frame.ID, err.Error())
+	}
+
+	for _, call := range frame.Calls {
+		in.lifetimes.Call(frame.ID, call)
+		in.memory.Call(frame.ID, call)
+		in.linter.Call(frame.ID, call)
+		in.state.AddCall(call)
+	}
+
+	in.memory.EndFrame(frame.ID)
+	in.linter.EndFrame(frame.ID)
+	in.portability.Add(frame)
+
+	if err := in.index.Add(frame); err != nil {
+		return fmt.Errorf("could not index frame %d: %s", frame.ID, err.Error())
+	}
+
+	return nil
+}
+
+// Store whatever could only be worked out once every frame had been seen
+func (in *ingester) finish() error {
+	header, err := in.index.Close()
+
+	if err != nil {
+		return fmt.Errorf("could not store the search index: %s", err.Error())
+	}
+
+	headerJSON, err := json.Marshal(header)
+
+	if err != nil {
+		return err
+	}
+
+	in.searchDB.Set(in.traceID, headerJSON)
+
+	statsJSON, err := json.Marshal(in.stats.Stats())
+
+	if err != nil {
+		return err
+	}
+
+	in.dumpDB.Set(statsID(in.traceID), statsJSON)
+
+	leaksJSON, err := json.Marshal(in.lifetimes.Report())
+
+	if err != nil {
+		return err
+	}
+
+	in.dumpDB.Set(leaksID(in.traceID), leaksJSON)
+
+	memoryJSON, err := json.Marshal(in.memory.Report())
+
+	if err != nil {
+		return err
+	}
+
+	in.dumpDB.Set(memoryID(in.traceID), memoryJSON)
+
+	lintJSON, err := json.Marshal(in.linter.Report())
+
+	if err != nil {
+		return err
+	}
+
+	in.dumpDB.Set(lintID(in.traceID), lintJSON)
+
+	portabilityJSON, err := json.Marshal(in.portability.Report())
+
+	if err != nil {
+		return err
+	}
+
+	in.dumpDB.Set(portabilityID(in.traceID), portabilityJSON)
+
+	catalogue := in.shaders.Catalogue()
+
+	// validate while the catalogue still holds the sources, which are stored separately
+	if err := storeValidations(in.shadersDB, in.traceID, validateShaders(in.validator, catalogue)); err != nil {
+		return fmt.Errorf("could not store the shader validations: %s", err.Error())
+	}
+
+	if err := storeCatalogue(in.shadersDB, in.traceID, catalogue); err != nil {
+		return fmt.Errorf("could not store the shader catalogue: %s", err.Error())
+	}
+
+	if err := recordUsage(in.searchDB, in.traceID, in.appID, in.usage); err != nil {
+		return fmt.Errorf("could not update the function usage index: %s", err.Error())
+	}
+
+	return nil
+}
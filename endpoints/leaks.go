@@ -0,0 +1,120 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/state"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+// What `apitrace leaks` reported for a trace, or why it could not be run
+type ApitraceLeaks struct {
+	Leaks []*parsers.ReportedLeak `json:"leaks"`
+	Error string                  `json:"error,omitempty"`
+}
+
+// The objects a trace leaked, deleted while they were bound, or used after deleting them, alongside the leaks apitrace
+// found itself when the toolchain has `apitrace leaks`
+type TraceLeaks struct {
+	*state.LeakReport
+	Apitrace *ApitraceLeaks `json:"apitrace,omitempty"`
+}
+
+func leaksID(traceID string) string {
+	return fmt.Sprintf("%s-leaks", traceID)
+}
+
+func apitraceLeaksID(traceID string) string {
+	return fmt.Sprintf("%s-apitrace-leaks", traceID)
+}
+
+// Store the output of `apitrace leaks` for a dumped trace, with the frame holding each call it names
+func storeApitraceLeaks(dumpDB *persistence.Cache, trace *Trace, stdout string, err error) error {
+	leaks := &ApitraceLeaks{Leaks: []*parsers.ReportedLeak{}}
+
+	if err != nil {
+		leaks.Error = err.Error()
+	} else {
+		leaks.Leaks = parsers.ParseLeaks(stdout)
+	}
+
+	for _, leak := range leaks.Leaks {
+		if leak.Call == nil {
+			continue
+		}
+
+		if stored, err := findFrame(dumpDB, trace, *leak.Call); err == nil {
+			frame := stored.ID
+			leak.Frame = &frame
+		}
+	}
+
+	leaksJSON, err := json.Marshal(leaks)
+
+	if err != nil {
+		return err
+	}
+
+	dumpDB.Set(apitraceLeaksID(trace.ID), leaksJSON)
+
+	return nil
+}
+
+// Report the GL objects a trace created and never deleted, deleted while they were still bound, or used after
+// deleting them. Every entry names the call and frame that created the object
+func GetTraceLeaks(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLeaks: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := dumpDB.Get(leaksID(traceName))
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLeaks: trace <%s> has no leak report, it was dumped before object lifetimes were tracked
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		leaks := &TraceLeaks{}
+
+		if err := json.Unmarshal(val.([]byte), &leaks.LeakReport); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLeaks: could not read the leak report of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		// apitrace's report is only there when the toolchain could run it
+		if val, err := dumpDB.Get(apitraceLeaksID(traceName)); err == nil {
+			if err := json.Unmarshal(val.([]byte), &leaks.Apitrace); err != nil {
+				w.WriteHeader(500)
+				w.Write([]byte(fmt.Sprintf(`GetTraceLeaks: could not read the apitrace leak report of trace <%s>
+Error: %s`, traceName, err.Error())))
+				return
+			}
+		}
+
+		leaksJSON, err := json.Marshal(leaks)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLeaks: could not marshal the leak report of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(leaksJSON)
+	}
+
+}
@@ -0,0 +1,81 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/lint"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func lintID(traceID string) string {
+	return fmt.Sprintf("%s-lint", traceID)
+}
+
+// Retrieve the performance problems found in a trace as it was dumped, in frame order. The findings can be narrowed
+// to one analyzer with `analyzer`, and to one severity with `severity`; the counts are always of every finding
+func GetTraceLint(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLint: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := dumpDB.Get(lintID(traceName))
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLint: trace <%s> has no lint report, it was dumped before traces were linted
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		var report lint.Report
+
+		if err := json.Unmarshal(val.([]byte), &report); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLint: could not read the lint report of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		query := r.URL.Query()
+
+		analyzer := query.Get("analyzer")
+		severity := query.Get("severity")
+
+		findings := []*lint.Finding{}
+
+		for _, finding := range report.Findings {
+			if len(analyzer) > 0 && finding.Analyzer != analyzer {
+				continue
+			}
+
+			if len(severity) > 0 && finding.Severity != severity {
+				continue
+			}
+
+			findings = append(findings, finding)
+		}
+
+		report.Findings = findings
+
+		reportJSON, err := json.Marshal(report)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceLint: could not marshal the lint report of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(reportJSON)
+	}
+
+}
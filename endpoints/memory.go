@@ -0,0 +1,81 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/state"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+// the number of objects listed at the peak and at the end of the trace when no top is given
+const defaultTopObjects = 20
+
+func memoryID(traceID string) string {
+	return fmt.Sprintf("%s-memory", traceID)
+}
+
+// Retrieve the estimated texture, buffer and renderbuffer memory of a trace at the end of every frame, with the frame
+// it peaked at and the largest objects alive then and at the end of the trace
+func GetTraceMemory(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		top, err := intParameter(r.URL.Query(), "top", defaultTopObjects)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetTraceMemory: invalid top for trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceMemory: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := dumpDB.Get(memoryID(traceName))
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceMemory: trace <%s> has no memory estimate, it was dumped before memory was estimated
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		var memory state.MemoryReport
+
+		if err := json.Unmarshal(val.([]byte), &memory); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceMemory: could not read the memory estimate of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		// a top of 0 lists every object
+		if top > 0 && len(memory.PeakObjects) > top {
+			memory.PeakObjects = memory.PeakObjects[:top]
+		}
+
+		if top > 0 && len(memory.Objects) > top {
+			memory.Objects = memory.Objects[:top]
+		}
+
+		memoryJSON, err := json.Marshal(memory)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceMemory: could not marshal the memory estimate of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(memoryJSON)
+	}
+
+}
@@ -0,0 +1,367 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+// Frames are stored in pages of this many calls, so reading a page of a large frame does not mean reading all of it
+const framePageSize = 500
+
+// What is stored under a frame's own key; the calls themselves are stored in pages under pageID
+type storedFrame struct {
+	ID            int          `json:"id"`
+	FirstCall     int          `json:"firstCall"`
+	LastCall      int          `json:"lastCall"`
+	Incomplete    bool         `json:"incomplete"`
+	NumberOfCalls int          `json:"numberOfCalls"`
+	Pages         []*framePage `json:"pages"`
+
+	// frames stored before paging hold all of their calls here instead
+	Calls []*parsers.Call `json:"calls,omitempty"`
+}
+
+// the calls covered by one page of a frame
+type framePage struct {
+	FirstCall int `json:"firstCall"`
+	LastCall  int `json:"lastCall"`
+	Calls     int `json:"calls"`
+}
+
+// A window onto the calls of a frame, after filtering. Next is the offset of the following page, if there is one
+type FramePage struct {
+	ID         int             `json:"id"`
+	FirstCall  int             `json:"firstCall"`
+	LastCall   int             `json:"lastCall"`
+	Incomplete bool            `json:"incomplete"`
+	Calls      []*parsers.Call `json:"calls"`
+	Offset     int             `json:"offset"`
+	Limit      int             `json:"limit"`
+	Total      int             `json:"total"`
+	Next       *int            `json:"next,omitempty"`
+}
+
+func frameID(traceID string, frame int) string {
+	return fmt.Sprintf("%s-%d", traceID, frame)
+}
+
+func pageID(traceID string, frame, page int) string {
+	return fmt.Sprintf("%s-%d-page-%d", traceID, frame, page)
+}
+
+// Store a frame in the dump cache, split into pages
+func storeFrame(dumpDB *persistence.Cache, traceID string, frame *parsers.Frame) error {
+	stored := &storedFrame{
+		ID:            frame.ID,
+		FirstCall:     frame.FirstCall,
+		LastCall:      frame.LastCall,
+		Incomplete:    frame.Incomplete,
+		NumberOfCalls: len(frame.Calls),
+		Pages:         []*framePage{},
+	}
+
+	for start := 0; start < len(frame.Calls); start += framePageSize {
+		end := start + framePageSize
+
+		if end > len(frame.Calls) {
+			end = len(frame.Calls)
+		}
+
+		page := &parsers.Frame{}
+
+		for _, call := range frame.Calls[start:end] {
+			page.AddCall(call)
+		}
+
+		pageJSON, err := json.Marshal(page.Calls)
+
+		if err != nil {
+			return err
+		}
+
+		dumpDB.Set(pageID(traceID, frame.ID, len(stored.Pages)), pageJSON)
+
+		stored.Pages = append(stored.Pages, &framePage{page.FirstCall, page.LastCall, len(page.Calls)})
+	}
+
+	frameJSON, err := json.Marshal(stored)
+
+	if err != nil {
+		return err
+	}
+
+	dumpDB.Set(frameID(traceID, frame.ID), frameJSON)
+
+	return nil
+}
+
+func loadFrame(dumpDB *persistence.Cache, traceID string, frame int) (*storedFrame, error) {
+	val, err := dumpDB.Get(frameID(traceID, frame))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stored storedFrame
+
+	if err := json.Unmarshal(val.([]byte), &stored); err != nil {
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+// Hand each page of calls in turn to visit. Pages skip says nothing can match are never read
+func (stored *storedFrame) eachPage(dumpDB *persistence.Cache, traceID string, skip func(*framePage) bool, visit func(calls []*parsers.Call)) error {
+	if len(stored.Pages) == 0 {
+		visit(stored.Calls)
+		return nil
+	}
+
+	for i, page := range stored.Pages {
+		if skip != nil && skip(page) {
+			continue
+		}
+
+		calls, err := loadPage(dumpDB, traceID, stored.ID, i)
+
+		if err != nil {
+			return err
+		}
+
+		visit(calls)
+	}
+
+	return nil
+}
+
+// Read every call in a frame back into memory
+func (stored *storedFrame) frame(dumpDB *persistence.Cache, traceID string) (*parsers.Frame, error) {
+	frame := &parsers.Frame{
+		ID:         stored.ID,
+		FirstCall:  stored.FirstCall,
+		LastCall:   stored.LastCall,
+		Incomplete: stored.Incomplete,
+		Calls:      []*parsers.Call{},
+	}
+
+	err := stored.eachPage(dumpDB, traceID, nil, func(calls []*parsers.Call) {
+		frame.Calls = append(frame.Calls, calls...)
+	})
+
+	return frame, err
+}
+
+// The calls of a frame to return, from the query string
+type callFilter struct {
+	thread    int
+	byContext bool
+	context   string
+	function  string
+	from      int
+	to        int
+	draws     bool
+	state     bool
+}
+
+func newCallFilter(query url.Values) (*callFilter, error) {
+	filter := &callFilter{thread: -1, from: -1, to: -1}
+
+	var err error
+
+	if filter.thread, err = intParameter(query, "thread", -1); err != nil {
+		return nil, err
+	}
+
+	if filter.from, err = intParameter(query, "from", -1); err != nil {
+		return nil, err
+	}
+
+	if filter.to, err = intParameter(query, "to", -1); err != nil {
+		return nil, err
+	}
+
+	_, filter.byContext = query["context"]
+	filter.context = query.Get("context")
+
+	filter.function = query.Get("function")
+
+	if _, err := path.Match(filter.function, ""); err != nil {
+		return nil, fmt.Errorf("invalid function pattern <%s>: %s", filter.function, err.Error())
+	}
+
+	filter.draws = query.Get("draws") == "true"
+	filter.state = query.Get("state") == "true"
+
+	return filter, nil
+}
+
+func (filter *callFilter) active() bool {
+	return filter.thread >= 0 || filter.byContext || len(filter.function) > 0 || filter.from >= 0 || filter.to >= 0 || filter.draws || filter.state
+}
+
+// whether a page lies wholly outside the call range asked for
+func (filter *callFilter) skipsPage(page *framePage) bool {
+	return (filter.from >= 0 && page.LastCall < filter.from) || (filter.to >= 0 && page.FirstCall > filter.to)
+}
+
+func (filter *callFilter) matches(call *parsers.Call) bool {
+	if filter.thread >= 0 && call.Thread != filter.thread {
+		return false
+	}
+
+	if filter.byContext && call.Context != filter.context {
+		return false
+	}
+
+	if len(filter.function) > 0 {
+		if matched, _ := path.Match(filter.function, call.FunctionName); !matched {
+			return false
+		}
+	}
+
+	if filter.from >= 0 || filter.to >= 0 {
+		number, err := strconv.Atoi(call.ID)
+
+		if err != nil || (filter.from >= 0 && number < filter.from) || (filter.to >= 0 && number > filter.to) {
+			return false
+		}
+	}
+
+	if filter.draws && !parsers.IsDrawCall(call.FunctionName) {
+		return false
+	}
+
+	if filter.state && !parsers.IsStateChange(call.FunctionName) {
+		return false
+	}
+
+	return true
+}
+
+// Read the calls from offset up to limit (every call when limit is 0) that pass the filter. Without a filter only the
+// pages holding the window are read; with one, every page that could match is read in turn to count the total
+func (stored *storedFrame) page(dumpDB *persistence.Cache, traceID string, filter *callFilter, offset, limit int) (*FramePage, error) {
+	result := &FramePage{
+		ID:         stored.ID,
+		FirstCall:  stored.FirstCall,
+		LastCall:   stored.LastCall,
+		Incomplete: stored.Incomplete,
+		Calls:      []*parsers.Call{},
+		Offset:     offset,
+		Limit:      limit,
+	}
+
+	inWindow := func(index int) bool {
+		return index >= offset && (limit == 0 || index < offset+limit)
+	}
+
+	var err error
+
+	if !filter.active() && len(stored.Pages) > 0 {
+		result.Total = stored.NumberOfCalls
+
+		first := offset / framePageSize
+
+		for i := first; i < len(stored.Pages) && (limit == 0 || i*framePageSize < offset+limit); i++ {
+			calls, err := loadPage(dumpDB, traceID, stored.ID, i)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for j, call := range calls {
+				if inWindow(i*framePageSize + j) {
+					result.Calls = append(result.Calls, call)
+				}
+			}
+		}
+	} else {
+		err = stored.eachPage(dumpDB, traceID, filter.skipsPage, func(calls []*parsers.Call) {
+			for _, call := range calls {
+				if !filter.matches(call) {
+					continue
+				}
+
+				if inWindow(result.Total) {
+					result.Calls = append(result.Calls, call)
+				}
+
+				result.Total++
+			}
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if limit > 0 && offset+limit < result.Total {
+		next := offset + limit
+		result.Next = &next
+	}
+
+	return result, nil
+}
+
+func loadPage(dumpDB *persistence.Cache, traceID string, frame, page int) ([]*parsers.Call, error) {
+	val, err := dumpDB.Get(pageID(traceID, frame, page))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var calls []*parsers.Call
+
+	err = json.Unmarshal(val.([]byte), &calls)
+
+	return calls, err
+}
+
+func intParameter(query url.Values, name string, missing int) (int, error) {
+	value := query.Get(name)
+
+	if len(value) == 0 {
+		return missing, nil
+	}
+
+	i, err := strconv.Atoi(value)
+
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("%s <%s> is not a positive number", name, value)
+	}
+
+	return i, nil
+}
+
+// Find the frame of a trace holding a call, by its first and last calls. Frames are numbered in the order their calls
+// were made, so the frames are searched by halves
+func findFrame(dumpDB *persistence.Cache, trace *Trace, call int) (*storedFrame, error) {
+	low, high := 0, trace.NumberOfFrames-1
+
+	for low <= high {
+		middle := (low + high) / 2
+
+		stored, err := loadFrame(dumpDB, trace.ID, middle)
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case call < stored.FirstCall:
+			high = middle - 1
+		case call > stored.LastCall:
+			low = middle + 1
+		default:
+			return stored, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no frame of trace <%s> holds call %d", trace.ID, call)
+}
@@ -0,0 +1,65 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/portability"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func portabilityID(traceID string) string {
+	return fmt.Sprintf("%s-portability", traceID)
+}
+
+// Report the lowest version of each API a trace can run on, the extensions it relies on and the functions and enums it
+// uses that the GL core profile removed, worked out from the Khronos registry as the trace was dumped. The list of
+// every symbol the trace uses is left out unless `symbols=true`
+func GetTracePortability(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTracePortability: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := dumpDB.Get(portabilityID(traceName))
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTracePortability: trace <%s> has no portability report, it was dumped before portability was checked
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		var report portability.Report
+
+		if err := json.Unmarshal(val.([]byte), &report); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTracePortability: could not read the portability report of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		if r.URL.Query().Get("symbols") != "true" {
+			report.Symbols = nil
+		}
+
+		reportJSON, err := json.Marshal(report)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTracePortability: could not marshal the portability report of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(reportJSON)
+	}
+
+}
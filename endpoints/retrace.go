@@ -6,11 +6,16 @@ import (
 	"github.com/fergloragain/apitrace-remote/operations"
 	"github.com/fergloragain/apitrace-remote/parsers"
 	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/shaders"
 	"github.com/julienschmidt/httprouter"
 	"log"
 	"net/http"
+	"strconv"
 )
 
+// A replay of a trace up to a call. glretrace runs twice, dumping the state as JSON and then as UBJSON, and
+// RetraceStderr is the stderr of the JSON run; the UBJSON run's stderr is dropped whenever that run succeeds, and only
+// replaces it when it fails
 type Retrace struct {
 	ID              string              `json:"id"`
 	AppID           string              `json:"appID"`
@@ -22,10 +27,17 @@ type Retrace struct {
 	RetraceStderr   string              `json:"retraceStderr"`
 	RetraceData     parsers.RetraceData `json:"retraceData"`
 	ImageSet        *parsers.ImageSet   `json:"imageSet"`
+	Toolchain       string              `json:"toolchain"`
+
+	// the uniforms of RetraceData with the types and names the current program's shaders declared them with
+	Uniforms []*shaders.UniformValue `json:"uniforms,omitempty"`
+
+	// the GL errors, debug output and mismatches glretrace reported on RetraceStderr
+	Diagnostics []*parsers.Diagnostic `json:"diagnostics"`
 }
 
 // Add a new Trace to the DB
-func AddRetrace(retraceDB, traceDB, appsDB *persistence.Cache) httprouter.Handle {
+func AddRetrace(retraceDB, traceDB, dumpDB, appsDB, shadersDB, toolchainsDB, configDB *persistence.Cache) httprouter.Handle {
 
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		appName := p.ByName("name")
@@ -65,6 +77,23 @@ func AddRetrace(retraceDB, traceDB, appsDB *persistence.Cache) httprouter.Handle
 			return
 		}
 
+		toolchain, err := selectToolchain(toolchainsDB, configDB, app, r.URL.Query().Get("toolchain"), trace.Toolchain)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf("Unable to select a toolchain for %s: %s", appName, err.Error())))
+			return
+		}
+
+		// traces made before the API was recorded were all desktop GL
+		api := trace.API
+
+		if operations.TraceAPI(api) == operations.EGL && !toolchain.Capabilities.EGLRetrace {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf("Toolchain %s has no eglretrace to replay %s traces with", toolchain.Name, api)))
+			return
+		}
+
 		retraceID := fmt.Sprintf("%s-%s", appName, callID)
 
 		val, err = retraceDB.Get(retraceID)
@@ -92,6 +121,9 @@ func AddRetrace(retraceDB, traceDB, appsDB *persistence.Cache) httprouter.Handle
 			"",
 			parsers.RetraceData{},
 			nil,
+			toolchain.Name,
+			nil,
+			[]*parsers.Diagnostic{},
 		}
 
 		retraceStatusJSON, err := json.Marshal(retraceStatus)
@@ -118,13 +150,21 @@ func AddRetrace(retraceDB, traceDB, appsDB *persistence.Cache) httprouter.Handle
 		// now, kick off the job asynchronously
 		go func() {
 
-			// trace the application
-			retraceStdout, retraceStderr, err := operations.Retrace(trace.TargetDirectory, app.Retrace, trace.TraceFile, callID)
+			backend := toolchain.backend(api, operations.Preload)
+
+			// replay the trace up to the call to capture the state
+			retraceStdout, retraceStderr, err := backend.StateSnapshot(trace.TargetDirectory, trace.TraceFile, callID)
 
-			if app.DumpImages {
+			if err != nil {
+				log.Println(err.Error())
+			}
+
+			if app.DumpImages && !toolchain.Capabilities.DumpImages {
+				log.Printf("Toolchain %s does not support dump-images, skipping image dump", toolchain.Name)
+			} else if app.DumpImages {
 				log.Println("Dumping images...")
 
-				imageDumpStdout, imageDumpStderr, err := operations.DumpImages(trace.TargetDirectory, app.APITrace, trace.TraceFile, callID)
+				traceImageDump, imageDumpStdout, imageDumpStderr, err := backend.DumpImages(trace.TargetDirectory, trace.TraceFile, callID)
 
 				log.Println("Finished dumping images...")
 
@@ -132,7 +172,6 @@ func AddRetrace(retraceDB, traceDB, appsDB *persistence.Cache) httprouter.Handle
 					log.Println(err.Error())
 				}
 
-				traceImageDump := parsers.ParseImageDumpFile(imageDumpStdout)
 				retraceStatus.ImageSet = traceImageDump
 				retraceStatus.ImageDumpStdout = imageDumpStdout
 				retraceStatus.ImageDumpStderr = imageDumpStderr
@@ -148,6 +187,24 @@ func AddRetrace(retraceDB, traceDB, appsDB *persistence.Cache) httprouter.Handle
 
 			retraceStatus.RetraceData = retraceStructs
 
+			retraceStatus.Diagnostics = parsers.ParseDiagnostics(retraceStderr)
+
+			diagnosticFrames(dumpDB, &trace, retraceStatus.Diagnostics)
+
+			if call, err := strconv.Atoi(callID); err == nil {
+				if err := recordDiagnostics(dumpDB, trace.ID, call, retraceStatus.Diagnostics); err != nil {
+					log.Printf("Unable to record the diagnostics of %s against its calls: %s", retraceID, err.Error())
+				}
+
+				uniforms, err := retraceUniforms(shadersDB, trace.ID, call, &retraceStructs)
+
+				if err != nil {
+					log.Printf("Unable to match the uniforms of %s with their declarations: %s", retraceID, err.Error())
+				}
+
+				retraceStatus.Uniforms = uniforms
+			}
+
 			retraceJSON, err := json.Marshal(retraceStatus)
 
 			if err != nil {
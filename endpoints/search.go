@@ -0,0 +1,228 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/search"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"strings"
+)
+
+// the number of hits returned when no limit is given
+const defaultSearchLimit = 50
+
+// snippets longer than this are cut short
+const maxSnippetLength = 200
+
+type SearchHit struct {
+	Frame    int    `json:"frame"`
+	CallID   string `json:"callID"`
+	Function string `json:"function"`
+	Snippet  string `json:"snippet"`
+}
+
+type SearchResults struct {
+	Query  string       `json:"query"`
+	Match  string       `json:"match"`
+	Offset int          `json:"offset"`
+	Limit  int          `json:"limit"`
+	Total  int          `json:"total"`
+	Next   *int         `json:"next,omitempty"`
+	Hits   []*SearchHit `json:"hits"`
+}
+
+// Search the calls of a trace through the index built when it was dumped
+func SearchTrace(traceDB, dumpDB, searchDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		query := r.URL.Query()
+
+		q, err := search.ParseQuery(query.Get("q"), query.Get("match"))
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`SearchTrace: invalid search <%s> in trace <%s>
+Error: %s`, query.Get("q"), traceName, err.Error())))
+			return
+		}
+
+		offset, err := intParameter(query, "offset", 0)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`SearchTrace: invalid offset for trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		limit, err := intParameter(query, "limit", defaultSearchLimit)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`SearchTrace: invalid limit for trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`SearchTrace: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		header, err := searchHeader(searchDB, traceName)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`SearchTrace: trace <%s> has no search index, it was dumped before traces were indexed
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		results := &SearchResults{
+			Query:  query.Get("q"),
+			Match:  query.Get("match"),
+			Offset: offset,
+			Limit:  limit,
+			Hits:   []*SearchHit{},
+		}
+
+		if len(results.Match) == 0 {
+			results.Match = search.ExactMatch
+		}
+
+		var window []search.Posting
+
+		for i := 0; i < header.Segments; i++ {
+			segment, err := searchSegment(searchDB, traceName, i)
+
+			if err != nil {
+				w.WriteHeader(500)
+				w.Write([]byte(fmt.Sprintf(`SearchTrace: could not read segment %d of the index for trace <%s>
+Error: %s`, i, traceName, err.Error())))
+				return
+			}
+
+			for _, posting := range segment.Search(q) {
+				if results.Total >= offset && (limit == 0 || results.Total < offset+limit) {
+					window = append(window, posting)
+				}
+
+				results.Total++
+			}
+		}
+
+		if limit > 0 && offset+limit < results.Total {
+			next := offset + limit
+			results.Next = &next
+		}
+
+		// only the pages holding the hits being returned are read, to build their snippets
+		pages := map[string][]*parsers.Call{}
+
+		for _, posting := range window {
+			page := posting.Index() / framePageSize
+			key := pageID(traceName, posting.Frame(), page)
+
+			if _, ok := pages[key]; !ok {
+				pages[key], err = loadPage(dumpDB, traceName, posting.Frame(), page)
+
+				if err != nil {
+					w.WriteHeader(500)
+					w.Write([]byte(fmt.Sprintf(`SearchTrace: could not read frame %d of trace <%s>
+Error: %s`, posting.Frame(), traceName, err.Error())))
+					return
+				}
+			}
+
+			calls := pages[key]
+			index := posting.Index() % framePageSize
+
+			if index >= len(calls) {
+				continue
+			}
+
+			results.Hits = append(results.Hits, &SearchHit{
+				Frame:    posting.Frame(),
+				CallID:   calls[index].ID,
+				Function: calls[index].FunctionName,
+				Snippet:  snippet(calls[index]),
+			})
+		}
+
+		resultsJSON, err := json.Marshal(results)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`SearchTrace: could not marshal search results for trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(resultsJSON)
+	}
+
+}
+
+func searchHeader(searchDB *persistence.Cache, traceID string) (*search.Header, error) {
+	val, err := searchDB.Get(traceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var header search.Header
+
+	err = json.Unmarshal(val.([]byte), &header)
+
+	return &header, err
+}
+
+func searchSegment(searchDB *persistence.Cache, traceID string, segment int) (*search.Segment, error) {
+	val, err := searchDB.Get(segmentID(traceID, segment))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var s search.Segment
+
+	err = json.Unmarshal(val.([]byte), &s)
+
+	return &s, err
+}
+
+func segmentID(traceID string, segment int) string {
+	return fmt.Sprintf("%s-segment-%d", traceID, segment)
+}
+
+// render a call the way apitrace dumps it, cut short if it is long
+func snippet(call *parsers.Call) string {
+	args := make([]string, len(call.ParamValues))
+
+	for i, value := range call.ParamValues {
+		if i < len(call.ParamNames) && len(call.ParamNames[i]) > 0 {
+			args[i] = fmt.Sprintf("%s = %s", call.ParamNames[i], value)
+		} else {
+			args[i] = value
+		}
+	}
+
+	s := fmt.Sprintf("%s(%s)", call.FunctionName, strings.Join(args, ", "))
+
+	if len(call.ReturnValue) > 0 {
+		s += " = " + call.ReturnValue
+	}
+
+	if runes := []rune(s); len(runes) > maxSnippetLength {
+		s = string(runes[:maxSnippetLength]) + "..."
+	}
+
+	return s
+}
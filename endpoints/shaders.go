@@ -0,0 +1,321 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/operations"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/shaders"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// Sources are shared by every trace using them, and traces are dumped concurrently
+var sourcesLock sync.Mutex
+
+// Store a trace's catalogue under its ID, and each of its sources once under its hash
+func storeCatalogue(shadersDB *persistence.Cache, traceID string, catalogue *shaders.Catalogue) error {
+	sourcesLock.Lock()
+	defer sourcesLock.Unlock()
+
+	for _, shader := range catalogue.Shaders {
+		source, err := loadSource(shadersDB, shader.Hash)
+
+		if err != nil {
+			source = &shaders.Source{Hash: shader.Hash, Stage: shader.Stage, Source: shader.Source, Traces: []string{}}
+		}
+
+		source.AddTrace(traceID)
+
+		sourceJSON, err := json.Marshal(source)
+
+		if err != nil {
+			return err
+		}
+
+		shadersDB.Set(shader.Hash, sourceJSON)
+
+		shader.Source = ""
+	}
+
+	catalogueJSON, err := json.Marshal(catalogue)
+
+	if err != nil {
+		return err
+	}
+
+	shadersDB.Set(traceID, catalogueJSON)
+
+	return nil
+}
+
+func loadCatalogue(shadersDB *persistence.Cache, traceID string) (*shaders.Catalogue, error) {
+	val, err := shadersDB.Get(traceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var catalogue shaders.Catalogue
+
+	err = json.Unmarshal(val.([]byte), &catalogue)
+
+	return &catalogue, err
+}
+
+func loadSource(shadersDB *persistence.Cache, hash string) (*shaders.Source, error) {
+	val, err := shadersDB.Get(hash)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var source shaders.Source
+
+	err = json.Unmarshal(val.([]byte), &source)
+
+	return &source, err
+}
+
+// Check every shader in a catalogue, running the validator once per distinct source
+func validateShaders(validator string, catalogue *shaders.Catalogue) []*shaders.Validation {
+	if len(validator) == 0 {
+		validator = "glslangValidator"
+	}
+
+	validations := []*shaders.Validation{}
+
+	for _, shader := range catalogue.Shaders {
+		validations = append(validations, shaders.Validate(shader, shader.Source, func(stage, source string) (string, error) {
+			return operations.ValidateShader(validator, shaders.StageExtension(stage), source)
+		}))
+	}
+
+	return validations
+}
+
+func validationsID(traceID string) string {
+	return fmt.Sprintf("%s-validation", traceID)
+}
+
+func storeValidations(shadersDB *persistence.Cache, traceID string, validations []*shaders.Validation) error {
+	validationsJSON, err := json.Marshal(validations)
+
+	if err != nil {
+		return err
+	}
+
+	shadersDB.Set(validationsID(traceID), validationsJSON)
+
+	return nil
+}
+
+func loadValidations(shadersDB *persistence.Cache, traceID string) ([]*shaders.Validation, error) {
+	val, err := shadersDB.Get(validationsID(traceID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var validations []*shaders.Validation
+
+	err = json.Unmarshal(val.([]byte), &validations)
+
+	return validations, err
+}
+
+// The uniforms of a retrace's state dump, matched with their declarations in the program that was current at the
+// retraced call
+func retraceUniforms(shadersDB *persistence.Cache, traceID string, call int, data *parsers.RetraceData) ([]*shaders.UniformValue, error) {
+	if len(data.Uniforms) == 0 {
+		return nil, nil
+	}
+
+	catalogue, err := loadCatalogue(shadersDB, traceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	validations, err := loadValidations(shadersDB, traceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := fmt.Sprintf("%v", data.Parameters["GL_CURRENT_PROGRAM"])
+
+	// the last link of the current program before the call
+	var program *shaders.Program
+
+	for _, p := range catalogue.Programs {
+		if number, err := strconv.Atoi(p.Call); err == nil && number <= call && p.Program == current {
+			program = p
+		}
+	}
+
+	var declared []*shaders.Validation
+
+	if program != nil {
+		for _, validation := range validations {
+			if containsHash(program.Shaders, validation.Hash) {
+				declared = append(declared, validation)
+			}
+		}
+	}
+
+	return shaders.DeclaredUniforms(data.Uniforms, declared), nil
+}
+
+func containsHash(hashes []string, hash string) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// List the validator's diagnostics and the declared interface of every shader in a trace
+func GetShaderValidations(traceDB, shadersDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetShaderValidations: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := shadersDB.Get(validationsID(traceName))
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetShaderValidations: trace <%s> has no shader validations, it was dumped before shaders were validated
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(val.([]byte))
+	}
+
+}
+
+// List the distinct shaders a trace created and the programs linked from them
+func GetTraceShaders(traceDB, shadersDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceShaders: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := shadersDB.Get(traceName)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceShaders: trace <%s> has no shader catalogue, it was dumped before shaders were catalogued
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(val.([]byte))
+	}
+
+}
+
+// Retrieve the source of a shader by its content hash, with the traces it appears in
+func GetShader(shadersDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		hash := p.ByName("id")
+
+		if !shaders.IsHash(hash) {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetShader: <%s> is not a shader hash`, hash)))
+			return
+		}
+
+		val, err := shadersDB.Get(hash)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetShader: could not find shader with hash: <%s>
+Error: %s`, hash, err.Error())))
+			return
+		}
+
+		w.Write(val.([]byte))
+	}
+
+}
+
+// Report the shaders added, removed and modified between two traces, with a unified diff of each
+func CompareShaders(traceDB, shadersDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceA := p.ByName("traceA")
+		traceB := p.ByName("traceB")
+
+		catalogues := make([]*shaders.Catalogue, 2)
+
+		for i, traceName := range []string{traceA, traceB} {
+			if _, err := traceDB.Get(traceName); err != nil {
+				w.WriteHeader(404)
+				w.Write([]byte(fmt.Sprintf(`CompareShaders: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+				return
+			}
+
+			catalogue, err := loadCatalogue(shadersDB, traceName)
+
+			if err != nil {
+				w.WriteHeader(404)
+				w.Write([]byte(fmt.Sprintf(`CompareShaders: trace <%s> has no shader catalogue, it was dumped before shaders were catalogued
+Error: %s`, traceName, err.Error())))
+				return
+			}
+
+			catalogues[i] = catalogue
+		}
+
+		comparison, err := shaders.Compare(traceA, traceB, catalogues[0], catalogues[1], func(hash string) (string, error) {
+			source, err := loadSource(shadersDB, hash)
+
+			if err != nil {
+				return "", err
+			}
+
+			return source.Source, nil
+		})
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`CompareShaders: could not compare the shaders of traces <%s> and <%s>
+Error: %s`, traceA, traceB, err.Error())))
+			return
+		}
+
+		comparisonJSON, err := json.Marshal(comparison)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`CompareShaders: could not marshal the comparison of traces <%s> and <%s>
+Error: %s`, traceA, traceB, err.Error())))
+			return
+		}
+
+		w.Write(comparisonJSON)
+	}
+
+}
@@ -0,0 +1,169 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/state"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"strconv"
+)
+
+// The GL state just after a call, on the context the call was made on
+type CallState struct {
+	Call     int    `json:"call"`
+	Frame    int    `json:"frame"`
+	Function string `json:"function"`
+	Thread   int    `json:"thread"`
+	*state.Snapshot
+}
+
+func stateID(traceID string, frame int) string {
+	return fmt.Sprintf("%s-%d-state", traceID, frame)
+}
+
+// Store the state tracked up to the start of a frame, so the state at any of its calls can be found from there
+func storeStateCheckpoint(dumpDB *persistence.Cache, traceID string, frame int, tracker *state.Tracker) error {
+	trackerJSON, err := json.Marshal(tracker)
+
+	if err != nil {
+		return err
+	}
+
+	dumpDB.Set(stateID(traceID, frame), trackerJSON)
+
+	return nil
+}
+
+// the state tracked up to the start of a frame; traces dumped before state was tracked are walked from their first frame
+func loadStateCheckpoint(dumpDB *persistence.Cache, traceID string, frame int) (*state.Tracker, error) {
+	if val, err := dumpDB.Get(stateID(traceID, frame)); err == nil {
+		tracker := state.NewTracker()
+
+		if err := json.Unmarshal(val.([]byte), tracker); err != nil {
+			return nil, err
+		}
+
+		return tracker, nil
+	}
+
+	tracker := state.NewTracker()
+
+	for i := 0; i < frame; i++ {
+		stored, err := loadFrame(dumpDB, traceID, i)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := stored.eachPage(dumpDB, traceID, nil, func(calls []*parsers.Call) {
+			for _, call := range calls {
+				tracker.AddCall(call)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return tracker, nil
+}
+
+// Work out the GL state at a call from the calls before it, without replaying the trace. Bindings, capabilities,
+// blend, depth and stencil settings and the uniforms of the current program are followed
+func GetCallState(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+		callParameter := p.ByName("call")
+
+		callNumber, err := strconv.Atoi(callParameter)
+
+		if err != nil || callNumber < 0 {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetCallState: invalid call <%s> for trace <%s>
+Error: not a call number`, callParameter, traceName)))
+			return
+		}
+
+		trace, err := getTrace(traceDB, traceName)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetCallState: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		stored, err := findFrame(dumpDB, trace, callNumber)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetCallState: could not find call %d in trace <%s>
+Error: %s`, callNumber, traceName, err.Error())))
+			return
+		}
+
+		tracker, err := loadStateCheckpoint(dumpDB, traceName, stored.ID)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetCallState: could not read the state at the start of frame %d of trace <%s>
+Error: %s`, stored.ID, traceName, err.Error())))
+			return
+		}
+
+		var found *parsers.Call
+
+		err = stored.eachPage(dumpDB, traceName, func(page *framePage) bool {
+			return page.FirstCall > callNumber
+		}, func(calls []*parsers.Call) {
+			for _, call := range calls {
+				if found != nil {
+					return
+				}
+
+				tracker.AddCall(call)
+
+				if call.ID == strconv.Itoa(callNumber) {
+					found = call
+				}
+			}
+		})
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetCallState: could not read the calls of frame %d of trace <%s>
+Error: %s`, stored.ID, traceName, err.Error())))
+			return
+		}
+
+		if found == nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetCallState: could not find call %d in trace <%s>
+Error: frame %d has no such call`, callNumber, traceName, stored.ID)))
+			return
+		}
+
+		callState := &CallState{
+			Call:     callNumber,
+			Frame:    stored.ID,
+			Function: found.FunctionName,
+			Thread:   found.Thread,
+			Snapshot: tracker.Snapshot(found.Context),
+		}
+
+		callStateJSON, err := json.Marshal(callState)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetCallState: could not marshal the state at call %d of trace <%s>
+Error: %s`, callNumber, traceName, err.Error())))
+			return
+		}
+
+		w.Write(callStateJSON)
+	}
+
+}
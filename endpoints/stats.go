@@ -0,0 +1,140 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"strconv"
+)
+
+// the number of functions listed in topFunctions when no top is given
+const defaultTopFunctions = 10
+
+func statsID(traceID string) string {
+	return fmt.Sprintf("%s-stats", traceID)
+}
+
+// frames are named apart from their number, or frame 1 of app-trace would share the key of trace app-trace-1
+func frameStatsID(traceID string, frame int) string {
+	return fmt.Sprintf("%s-frame-%d-stats", traceID, frame)
+}
+
+// Retrieve the statistics of a whole trace, with the counts of every frame as a timeline
+func GetTraceStats(traceDB, dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+
+		top, err := intParameter(r.URL.Query(), "top", defaultTopFunctions)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetTraceStats: invalid top for trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		if _, err := traceDB.Get(traceName); err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceStats: could not find trace with ID: <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := dumpDB.Get(statsID(traceName))
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetTraceStats: trace <%s> has no statistics, it was dumped before they were collected
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		var stats parsers.TraceStats
+
+		if err := json.Unmarshal(val.([]byte), &stats); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceStats: could not read the statistics of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		stats.TopFunctions = parsers.TopFunctions(stats.Functions, top)
+		stats.Functions = nil
+
+		statsJSON, err := json.Marshal(stats)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetTraceStats: could not marshal the statistics of trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		w.Write(statsJSON)
+	}
+
+}
+
+// Retrieve the statistics of one frame of a trace
+func GetFrameStats(dumpDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		traceName := p.ByName("name")
+		frameParameter := p.ByName("frame")
+
+		frame, err := strconv.Atoi(frameParameter)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetFrameStats: invalid frame <%s> for trace <%s>
+Error: %s`, frameParameter, traceName, err.Error())))
+			return
+		}
+
+		top, err := intParameter(r.URL.Query(), "top", defaultTopFunctions)
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`GetFrameStats: invalid top for trace <%s>
+Error: %s`, traceName, err.Error())))
+			return
+		}
+
+		val, err := dumpDB.Get(frameStatsID(traceName, frame))
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetFrameStats: could not find statistics for frame %d of trace <%s>
+Error: %s`, frame, traceName, err.Error())))
+			return
+		}
+
+		var stats parsers.FrameStats
+
+		if err := json.Unmarshal(val.([]byte), &stats); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetFrameStats: could not read the statistics of frame %d of trace <%s>
+Error: %s`, frame, traceName, err.Error())))
+			return
+		}
+
+		stats.TopFunctions = parsers.TopFunctions(stats.Functions, top)
+		stats.Functions = nil
+
+		statsJSON, err := json.Marshal(stats)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetFrameStats: could not marshal the statistics of frame %d of trace <%s>
+Error: %s`, frame, traceName, err.Error())))
+			return
+		}
+
+		w.Write(statsJSON)
+	}
+
+}
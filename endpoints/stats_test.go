@@ -0,0 +1,126 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"github.com/dgraph-io/badger"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/julienschmidt/httprouter"
+	"net/http/httptest"
+	"testing"
+)
+
+// The second trace of an app is named like a frame of the first, e.g. app-trace-1, so the statistics of both are stored
+// and read back side by side
+func TestStatsOfTracesOfOneApp(t *testing.T) {
+	opts := badger.DefaultOptions
+	opts.Dir = t.TempDir()
+	opts.ValueDir = opts.Dir
+	db, err := badger.Open(opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	traceDB := persistence.NewCache(db, "trace")
+	dumpDB := persistence.NewCache(db, "dump")
+	searchDB := persistence.NewCache(db, "search")
+	shadersDB := persistence.NewCache(db, "shaders")
+
+	dumps := []string{
+		// two frames, the second of a single draw
+		`0 glClear(mask = GL_COLOR_BUFFER_BIT)
+1 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+2 glDrawArrays(mode = GL_TRIANGLES, first = 0, count = 3)
+3 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)`,
+
+		// a single frame of three calls
+		`0 glClear(mask = GL_COLOR_BUFFER_BIT)
+1 glFlush()
+2 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)`,
+	}
+
+	var traceIDs []string
+
+	for _, dump := range dumps {
+		traceID := traceDB.GetValidID("app-trace")
+		traceIDs = append(traceIDs, traceID)
+
+		traceJSON, _ := json.Marshal(Trace{ID: traceID, AppID: "app", Name: traceID})
+		traceDB.Set(traceID, traceJSON)
+
+		ingest := newIngester(traceID, "app", "", dumpDB, searchDB, shadersDB)
+
+		for _, frame := range parsers.ParseDump(dump).Frames {
+			if err := ingest.handleFrame(frame); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := ingest.finish(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if traceIDs[1] != "app-trace-1" {
+		t.Fatalf("expected the second trace to be app-trace-1, got %s", traceIDs[1])
+	}
+
+	tests := []struct {
+		name   string
+		handle httprouter.Handle
+		params httprouter.Params
+		calls  int
+	}{
+		{
+			name:   "first trace",
+			handle: GetTraceStats(traceDB, dumpDB),
+			params: httprouter.Params{{Key: "name", Value: "app-trace"}},
+			calls:  4,
+		},
+		{
+			name:   "second trace",
+			handle: GetTraceStats(traceDB, dumpDB),
+			params: httprouter.Params{{Key: "name", Value: "app-trace-1"}},
+			calls:  3,
+		},
+		{
+			name:   "frame 1 of the first trace",
+			handle: GetFrameStats(dumpDB),
+			params: httprouter.Params{{Key: "name", Value: "app-trace"}, {Key: "frame", Value: "1"}},
+			calls:  2,
+		},
+		{
+			name:   "frame 0 of the second trace",
+			handle: GetFrameStats(dumpDB),
+			params: httprouter.Params{{Key: "name", Value: "app-trace-1"}, {Key: "frame", Value: "0"}},
+			calls:  3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			test.handle(w, httptest.NewRequest("GET", "/", nil), test.params)
+
+			if w.Code != 200 {
+				t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+			}
+
+			var stats struct {
+				parsers.Stats
+			}
+
+			if err := json.Unmarshal(w.Body.Bytes(), &stats); err != nil {
+				t.Fatal(err)
+			}
+
+			if stats.Calls != test.calls {
+				t.Errorf("expected %d calls, got %d", test.calls, stats.Calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/operations"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/julienschmidt/httprouter"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+type Toolchain struct {
+	Name            string                  `json:"name"`
+	Backend         string                  `json:"backend"`
+	APITrace        string                  `json:"apiTrace"`
+	Retrace         string                  `json:"retrace"`
+	EGLRetrace      string                  `json:"eglRetrace,omitempty"`
+	Capture         string                  `json:"capture"`
+	Convert         string                  `json:"convert"`
+	Replay          string                  `json:"replay"`
+	APITraceVersion string                  `json:"apiTraceVersion"`
+	RetraceVersion  string                  `json:"retraceVersion"`
+	Capabilities    operations.Capabilities `json:"capabilities"`
+}
+
+type NewToolchainRequest struct {
+	Name       string `json:"name"`
+	Backend    string `json:"backend"`
+	APITrace   string `json:"apiTrace"`
+	Retrace    string `json:"retrace"`
+	EGLRetrace string `json:"eglRetrace"`
+	Capture    string `json:"capture"`
+	Convert    string `json:"convert"`
+	Replay     string `json:"replay"`
+}
+
+// Get the details of every toolchain registered on the server
+func GetToolchains(toolchainsDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		toolchains := []Toolchain{}
+
+		for _, name := range toolchainsDB.TopLevelKeys() {
+			toolchain, err := getToolchain(toolchainsDB, name)
+
+			if err != nil {
+				w.WriteHeader(500)
+				w.Write([]byte(fmt.Sprintf(`GetToolchains: could not get toolchain <%s>
+Error: %s`, name, err.Error())))
+				return
+			}
+
+			toolchains = append(toolchains, *toolchain)
+		}
+
+		toolchainsJSON, err := json.Marshal(toolchains)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`GetToolchains: could not marshal toolchains
+Error: %s`, err.Error())))
+			return
+		}
+
+		w.Write(toolchainsJSON)
+	}
+}
+
+// Register a new tracer and replayer, after checking their binaries can be run
+func AddToolchain(toolchainsDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		var ntr NewToolchainRequest
+
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, 1048576))
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`AddToolchain: could not read request body
+Error: %s`, err.Error())))
+			return
+		}
+
+		if err := r.Body.Close(); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`AddToolchain: could not close body
+Error: %s`, err.Error())))
+			return
+		}
+
+		if err := json.Unmarshal(body, &ntr); err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`AddToolchain: could not unmarshal request body
+Error: %s`, err.Error())))
+			return
+		}
+
+		if len(ntr.Name) == 0 {
+			w.WriteHeader(400)
+			w.Write([]byte("AddToolchain: a toolchain name is required"))
+			return
+		}
+
+		if existing, _ := toolchainsDB.Get(ntr.Name); existing != nil {
+			w.WriteHeader(409)
+			w.Write([]byte(fmt.Sprintf("AddToolchain: a toolchain named <%s> already exists", ntr.Name)))
+			return
+		}
+
+		if len(ntr.Backend) == 0 {
+			ntr.Backend = operations.APITraceBackend
+		}
+
+		if ntr.Backend != operations.APITraceBackend && ntr.Backend != operations.GFXReconstructBackend {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf("AddToolchain: unknown backend <%s>", ntr.Backend)))
+			return
+		}
+
+		toolchain := Toolchain{
+			Name:       ntr.Name,
+			Backend:    ntr.Backend,
+			APITrace:   ntr.APITrace,
+			Retrace:    ntr.Retrace,
+			EGLRetrace: ntr.EGLRetrace,
+			Capture:    ntr.Capture,
+			Convert:    ntr.Convert,
+			Replay:     ntr.Replay,
+		}
+
+		backend := toolchain.backend(operations.GL, operations.Preload)
+
+		if err := backend.Validate(); err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`AddToolchain: invalid binary
+Error: %s`, err.Error())))
+			return
+		}
+
+		workingDirectory := os.TempDir()
+
+		toolchain.APITraceVersion, toolchain.RetraceVersion = backend.Versions(workingDirectory)
+		toolchain.Capabilities = backend.Capabilities(workingDirectory)
+
+		if len(toolchain.APITraceVersion) == 0 {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf("AddToolchain: the <%s> tracer did not report a version", toolchain.Backend)))
+			return
+		}
+
+		toolchainJSON, err := json.Marshal(toolchain)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`AddToolchain: could not marshal toolchain JSON
+Error: %s`, err.Error())))
+			return
+		}
+
+		toolchainsDB.Set(toolchain.Name, toolchainJSON)
+		w.Write(toolchainJSON)
+	}
+}
+
+// Retrieve a single toolchain by name
+func GetToolchain(toolchainsDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		name := p.ByName("name")
+
+		toolchainJSON, err := toolchainsDB.Get(name)
+
+		if err != nil {
+			w.WriteHeader(404)
+			w.Write([]byte(fmt.Sprintf(`GetToolchain: could not get toolchain <%s>
+Error: %s`, name, err.Error())))
+			return
+		}
+
+		w.Write(toolchainJSON.([]byte))
+	}
+}
+
+func getToolchain(toolchainsDB *persistence.Cache, name string) (*Toolchain, error) {
+	val, err := toolchainsDB.Get(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var toolchain Toolchain
+
+	if err := json.Unmarshal(val.([]byte), &toolchain); err != nil {
+		return nil, err
+	}
+
+	return &toolchain, nil
+}
+
+// Pick the toolchain for a job: the first non-empty name given wins, then the app's own toolchain. Apps that predate
+// the registry keep using the binary paths stored on them, and anything else uses the server's default toolchain
+func selectToolchain(toolchainsDB, configDB *persistence.Cache, app App, names ...string) (*Toolchain, error) {
+
+	names = append(names, app.Toolchain)
+
+	if len(app.APITrace) == 0 {
+		names = append(names, getConfig(configDB).DefaultToolchain)
+	}
+
+	for _, name := range names {
+		if len(name) > 0 {
+			toolchain, err := getToolchain(toolchainsDB, name)
+
+			if err != nil {
+				return nil, fmt.Errorf("toolchain <%s> is not registered: %s", name, err.Error())
+			}
+
+			return toolchain, nil
+		}
+	}
+
+	if len(app.APITrace) == 0 {
+		return nil, fmt.Errorf("app <%s> has no toolchain and the server has no default toolchain", app.ID)
+	}
+
+	return &Toolchain{
+		Backend:  operations.APITraceBackend,
+		APITrace: app.APITrace,
+		Retrace:  app.Retrace,
+		Capabilities: operations.Capabilities{
+			DumpImages: true,
+			PGPU:       true,
+			UBJSON:     true,
+			EGLRetrace: true,
+		},
+	}, nil
+}
+
+// The apitrace backend uses the apitrace, glretrace and eglretrace binaries, the GFXReconstruct backend uses capture,
+// convert and replay. The trace mode only matters to capturing, see operations.ValidateTraceMode
+func (toolchain *Toolchain) backend(api, traceMode string) operations.Backend {
+	if toolchain.Backend == operations.GFXReconstructBackend {
+		return operations.NewBackend(toolchain.Backend, toolchain.Capture, toolchain.Convert, toolchain.Replay, "", api,
+			traceMode)
+	}
+
+	return operations.NewBackend(toolchain.Backend, toolchain.APITrace, "", toolchain.Retrace, toolchain.EGLRetrace, api,
+		traceMode)
+}
@@ -1,15 +1,15 @@
 package endpoints
 
 import (
-	"bufio"
 	"encoding/json"
 	"fmt"
 	"github.com/fergloragain/apitrace-remote/operations"
-	"github.com/fergloragain/apitrace-remote/parsers"
 	"github.com/fergloragain/apitrace-remote/persistence"
 	"github.com/julienschmidt/httprouter"
 	"log"
 	"net/http"
+	"net/url"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -20,29 +20,58 @@ const (
 )
 
 type Trace struct {
-	ID              string   `json:"id"`
-	AppID           string   `json:"appID"`
-	Name            string   `json:"name"`
-	Status          string   `json:"status"`
-	BuildStdout     string   `json:"buildStdout"`
-	BuildStderr     string   `json:"buildStderr"`
-	TraceStdout     string   `json:"traceStdout"`
-	TraceStderr     string   `json:"traceStderr"`
-	CloneStdout     string   `json:"cloneStdout"`
-	CloneStderr     string   `json:"cloneStderr"`
-	DumpStderr      string   `json:"dumpStderr"`
-	TargetDirectory string   `json:"targetDirectory"`
-	NumberOfFrames  int      `json:"numberOfFrames"`
-	Retraces        []string `json:"retraces"`
-	TraceFile       string   `json:"traceFile"`
+	ID              string                  `json:"id"`
+	AppID           string                  `json:"appID"`
+	Name            string                  `json:"name"`
+	Status          string                  `json:"status"`
+	BuildStdout     string                  `json:"buildStdout"`
+	BuildStderr     string                  `json:"buildStderr"`
+	TraceStdout     string                  `json:"traceStdout"`
+	TraceStderr     string                  `json:"traceStderr"`
+	CloneStdout     string                  `json:"cloneStdout"`
+	CloneStderr     string                  `json:"cloneStderr"`
+	DumpStderr      string                  `json:"dumpStderr"`
+	TargetDirectory string                  `json:"targetDirectory"`
+	NumberOfFrames  int                     `json:"numberOfFrames"`
+	Retraces        []string                `json:"retraces"`
+	TraceFile       string                  `json:"traceFile"`
+	Environment     *operations.Environment `json:"environment"`
+	Toolchain       string                  `json:"toolchain"`
+	API             string                  `json:"api"`
 }
 
-// Get a list of all the app IDs within the DB
+// Get a list of all the trace IDs within the DB, optionally filtered by the environment they were recorded in
 func GetTraces(traceDB *persistence.Cache) httprouter.Handle {
 
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		allTraces := traceDB.TopLevelKeys()
 
+		filters := environmentFilters(r.URL.Query())
+
+		if len(filters) > 0 {
+			matchingTraces := []string{}
+
+			for _, traceID := range allTraces {
+				val, err := traceDB.Get(traceID)
+
+				if err != nil {
+					continue
+				}
+
+				var trace Trace
+
+				if err := json.Unmarshal(val.([]byte), &trace); err != nil {
+					continue
+				}
+
+				if matchesEnvironment(trace.Environment, filters) {
+					matchingTraces = append(matchingTraces, traceID)
+				}
+			}
+
+			allTraces = matchingTraces
+		}
+
 		tracesJSON, err := json.Marshal(allTraces)
 
 		if err != nil {
@@ -58,7 +87,7 @@ Error: %s`, err.Error())))
 }
 
 // Add a new Trace to the DB
-func AddTrace(traceDB, appsDB, dumpDB *persistence.Cache) httprouter.Handle {
+func AddTrace(traceDB, appsDB, dumpDB, searchDB, shadersDB, toolchainsDB, configDB *persistence.Cache) httprouter.Handle {
 
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		appName := p.ByName("name")
@@ -78,7 +107,7 @@ Error: %s`, appName, err.Error())))
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`AddTrace: Unable to unmarshal app data
-Error: %s`, appName, err.Error())))
+Error: %s`, err.Error())))
 			return
 		}
 
@@ -88,6 +117,15 @@ Error: %s`, appName, err.Error())))
 			return
 		}
 
+		toolchain, err := selectToolchain(toolchainsDB, configDB, app, r.URL.Query().Get("toolchain"))
+
+		if err != nil {
+			w.WriteHeader(400)
+			w.Write([]byte(fmt.Sprintf(`AddTrace: Unable to select a toolchain for <%s>
+Error: %s`, appName, err.Error())))
+			return
+		}
+
 		potentialTraceID := fmt.Sprintf("%s-trace", app.ID)
 		traceID := traceDB.GetValidID(potentialTraceID)
 
@@ -103,6 +141,8 @@ Error: %s`, appName, err.Error())))
 			TargetDirectory: "",
 			NumberOfFrames:  0,
 			Retraces:        []string{},
+			Toolchain:       toolchain.Name,
+			API:             app.API,
 		}
 
 		traceStatusJSON, err := json.Marshal(traceStatus)
@@ -110,7 +150,7 @@ Error: %s`, appName, err.Error())))
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`AddTrace: Unable to marshal trace status data
-Error: %s`, appName, err.Error())))
+Error: %s`, err.Error())))
 			return
 		}
 
@@ -126,7 +166,7 @@ Error: %s`, appName, err.Error())))
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(fmt.Sprintf(`AddTrace: Unable to marshal app data
-Error: %s`, appName, err.Error())))
+Error: %s`, err.Error())))
 			return
 		}
 
@@ -159,16 +199,16 @@ Error: %s`, appName, err.Error())))
 				return
 			}
 
-
 			fmt.Println("buildStdout")
 			fmt.Println(buildStdout)
 
-
 			fmt.Println("buildStderr")
 			fmt.Println(buildStderr)
 
+			backend := toolchain.backend(app.API, app.TraceMode)
+
 			// trace the application
-			traceStdout, traceStderr, err := operations.Trace(targetDirectory, app.APITrace, app.Executable, app.Timeout)
+			traceFile, traceStdout, traceStderr, err := backend.Capture(targetDirectory, app.Executable, app.Timeout)
 
 			fmt.Println("Trace finished")
 
@@ -180,34 +220,35 @@ Error: %s`, appName, err.Error())))
 			fmt.Println("traceStdout")
 			fmt.Println(traceStdout)
 
-
 			fmt.Println("traceStderr")
 			fmt.Println(traceStderr)
 
-
-			// get the tracefile name
-			traceFile := getTraceFile(traceStderr)
+			// record the toolchain and host that produced the trace, so it can be reproduced later
+			environment := operations.CollectEnvironment(targetDirectory, backend)
 
 			fmt.Println("Tracefile is")
 			fmt.Println(traceFile)
 
+			ingest := newIngester(traceID, app.ID, getConfig(configDB).ShaderValidator, dumpDB, searchDB, shadersDB)
 
-			// dump the trace file
-			dumpStdout, dumpStderr, err := operations.Dump(targetDirectory, app.APITrace, traceFile)
-
-			fmt.Println("dumpStdout")
-			fmt.Println(dumpStdout)
-
+			// dump the trace file, storing and indexing each frame as soon as it has been parsed
+			numberOfFrames, dumpStderr, err := backend.Dump(targetDirectory, traceFile, ingest.handleFrame)
 
 			fmt.Println("dumpStderr")
 			fmt.Println(dumpStderr)
 
-
 			if err != nil {
 				log.Println(fmt.Sprintf("AddTrace: Error dumping trace %s: %s", traceFile, err.Error()))
 				return
 			}
 
+			if err := ingest.finish(); err != nil {
+				log.Println(fmt.Sprintf("AddTrace: Error finishing the dump of trace %s: %s", traceFile, err.Error()))
+				return
+			}
+
+			fmt.Println("Dump finished")
+
 			// once all processes have finished, mark the trace status as complete, and save in the build and trace outputs
 			traceStatus.Status = Complete
 			traceStatus.BuildStdout = buildStdout
@@ -221,31 +262,20 @@ Error: %s`, appName, err.Error())))
 
 			traceStatus.TargetDirectory = targetDirectory
 
-			traceDump := parsers.ParseDump(dumpStdout)
-
-			fmt.Println("ParseDump finished")
-			//fmt.Println(traceDump)
-
-
-			// since timout kills the trace, the last frame will probably always be only partially complete, so we want to drop it from the frame collection
-			if len(traceDump.Frames) > 0 {
-				traceDump.Frames = traceDump.Frames[:len(traceDump.Frames)-1]
-			}
+			environment.GLVendor = ingest.glStrings.Vendor
+			environment.GLRenderer = ingest.glStrings.Renderer
+			environment.GLVersion = ingest.glStrings.Version
 
-			traceStatus.NumberOfFrames = len(traceDump.Frames)
+			traceStatus.Environment = environment
 
-			for i, frame := range traceDump.Frames {
+			traceStatus.NumberOfFrames = numberOfFrames
 
-				frameID := fmt.Sprintf("%s-%d", traceID, i)
+			if toolchain.Capabilities.Leaks {
+				leaksStdout, _, err := backend.Leaks(targetDirectory, traceFile)
 
-				dumpFrame, err := json.Marshal(frame)
-
-				if err != nil {
-					log.Println(fmt.Sprintf("AddTrace: Error marshalling frame %d: %s", i, err.Error()))
-					return
+				if err := storeApitraceLeaks(dumpDB, &traceStatus, leaksStdout, err); err != nil {
+					log.Println(fmt.Sprintf("AddTrace: Error storing the apitrace leaks of trace %s: %s", traceFile, err.Error()))
 				}
-
-				dumpDB.Set(frameID, dumpFrame)
 			}
 
 			updatedTraceStatusJSON, err := json.Marshal(traceStatus)
@@ -366,6 +396,45 @@ func DeleteTrace(traceDB *persistence.Cache) httprouter.Handle {
 
 }
 
+// the query parameters that can be used to filter trace listings, mapped to the environment field they match against
+var environmentFields = map[string]func(*operations.Environment) string{
+	"apiTraceVersion": func(e *operations.Environment) string { return e.APITraceVersion },
+	"retraceVersion":  func(e *operations.Environment) string { return e.RetraceVersion },
+	"glVendor":        func(e *operations.Environment) string { return e.GLVendor },
+	"glRenderer":      func(e *operations.Environment) string { return e.GLRenderer },
+	"glVersion":       func(e *operations.Environment) string { return e.GLVersion },
+	"kernel":          func(e *operations.Environment) string { return e.Kernel },
+	"distro":          func(e *operations.Environment) string { return e.Distro },
+	"hostname":        func(e *operations.Environment) string { return e.Hostname },
+}
+
+func environmentFilters(query url.Values) map[string]string {
+	filters := map[string]string{}
+
+	for field := range environmentFields {
+		if value := query.Get(field); len(value) > 0 {
+			filters[field] = strings.ToLower(value)
+		}
+	}
+
+	return filters
+}
+
+// a trace matches when every filter is a case insensitive substring of the corresponding environment field
+func matchesEnvironment(environment *operations.Environment, filters map[string]string) bool {
+	if environment == nil {
+		return false
+	}
+
+	for field, value := range filters {
+		if !strings.Contains(strings.ToLower(environmentFields[field](environment)), value) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func remove(slice []string, s string) []string {
 	for i, v := range slice {
 		if v == s {
@@ -377,25 +446,27 @@ func remove(slice []string, s string) []string {
 	return slice
 }
 
-func getTraceFile(traceStdErr string) string {
+func getTrace(traceDB *persistence.Cache, name string) (*Trace, error) {
+	val, err := traceDB.Get(name)
 
-	traceFilePath := ""
-	scanner := bufio.NewScanner(strings.NewReader(traceStdErr))
+	if err != nil {
+		return nil, err
+	}
 
-	for scanner.Scan() {
-		line := scanner.Text()
+	var trace Trace
 
-		stringFields := strings.Fields(line)
+	if err := json.Unmarshal(val.([]byte), &trace); err != nil {
+		return nil, err
+	}
 
-		if strings.HasPrefix(line, "apitrace:") {
-			if strings.Contains(line, "tracing to") {
-				traceFilePath = stringFields[len(stringFields)-1]
-				break
-			}
-		}
+	return &trace, nil
+}
 
+// The trace file is recorded as the tracer announced it, which may be relative to the directory the app was traced in
+func (trace *Trace) tracePath() string {
+	if filepath.IsAbs(trace.TraceFile) {
+		return trace.TraceFile
 	}
 
-	return traceFilePath
-
+	return filepath.Join(trace.TargetDirectory, trace.TraceFile)
 }
@@ -0,0 +1,138 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/persistence"
+	"github.com/fergloragain/apitrace-remote/registry"
+	"github.com/fergloragain/apitrace-remote/search"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"sync"
+)
+
+// Traces are dumped concurrently, and each one updates records shared with every other trace
+var usageLock sync.Mutex
+
+func functionUsageID(function string) string {
+	return fmt.Sprintf("usage-function-%s", function)
+}
+
+func extensionUsageID(extension string) string {
+	return fmt.Sprintf("usage-extension-%s", extension)
+}
+
+// Add a trace's call counts to the usage record of every function and extension it uses, replacing whatever an
+// earlier dump of the same trace recorded
+func recordUsage(searchDB *persistence.Cache, traceID, appID string, usage *search.Usage) error {
+	usageLock.Lock()
+	defer usageLock.Unlock()
+
+	for function, calls := range usage.Functions {
+		if err := addUsage(searchDB, functionUsageID(function), function, traceID, appID, calls); err != nil {
+			return err
+		}
+	}
+
+	for extension, calls := range usage.Extensions {
+		if err := addUsage(searchDB, extensionUsageID(extension), extension, traceID, appID, calls); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addUsage(searchDB *persistence.Cache, key, name, traceID, appID string, calls int) error {
+	record, err := loadUsage(searchDB, key, name)
+
+	if err != nil {
+		return err
+	}
+
+	record.Traces[traceID] = &search.TraceCalls{App: appID, Calls: calls}
+
+	recordJSON, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	searchDB.Set(key, recordJSON)
+
+	return nil
+}
+
+// Read a usage record, or an empty one if nothing has used the function or extension yet
+func loadUsage(searchDB *persistence.Cache, key, name string) (*search.UsageRecord, error) {
+	record := search.NewUsageRecord(name)
+
+	val, err := searchDB.Get(key)
+
+	if err != nil {
+		return record, nil
+	}
+
+	if err := json.Unmarshal(val.([]byte), record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Report which apps and traces call a function, and how often, across every trace dumped on the server
+func SearchFunction(searchDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		function := p.ByName("fn")
+
+		record, err := loadUsage(searchDB, functionUsageID(function), function)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`SearchFunction: could not read the usage of function <%s>
+Error: %s`, function, err.Error())))
+			return
+		}
+
+		report := record.Report()
+		report.Extensions = registry.FunctionExtensions(function)
+
+		writeUsageReport(w, "SearchFunction", report)
+	}
+
+}
+
+// Report which apps and traces rely on an extension, through its functions or enums, across every trace dumped on the
+// server
+func SearchExtension(searchDB *persistence.Cache) httprouter.Handle {
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		extension := p.ByName("ext")
+
+		record, err := loadUsage(searchDB, extensionUsageID(extension), extension)
+
+		if err != nil {
+			w.WriteHeader(500)
+			w.Write([]byte(fmt.Sprintf(`SearchExtension: could not read the usage of extension <%s>
+Error: %s`, extension, err.Error())))
+			return
+		}
+
+		writeUsageReport(w, "SearchExtension", record.Report())
+	}
+
+}
+
+func writeUsageReport(w http.ResponseWriter, handler string, report *search.UsageReport) {
+	reportJSON, err := json.Marshal(report)
+
+	if err != nil {
+		w.WriteHeader(500)
+		w.Write([]byte(fmt.Sprintf(`%s: could not marshal the usage of <%s>
+Error: %s`, handler, report.Name, err.Error())))
+		return
+	}
+
+	w.Write(reportJSON)
+}
@@ -4,10 +4,11 @@ go 1.12
 
 require (
 	github.com/AndreasBriese/bbloom v0.0.0-20190306092124-e2d15f34fcf9 // indirect
-	github.com/dgraph-io/badger v1.5.4 // indirect
+	github.com/dgraph-io/badger v1.5.4
 	github.com/dgryski/go-farm v0.0.0-20190423205320-6a90982ecee2 // indirect
 	github.com/golang/protobuf v1.3.1 // indirect
-	github.com/julienschmidt/httprouter v1.2.0 // indirect
+	github.com/golang/snappy v0.0.1
+	github.com/julienschmidt/httprouter v1.2.0
 	golang.org/x/crypto v0.0.0-20190426145343-a29dc8fdc734
 	gopkg.in/src-d/go-git.v4 v4.11.0
 )
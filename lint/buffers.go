@@ -0,0 +1,120 @@
+package lint
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/state"
+	"sort"
+)
+
+// frames a buffer has its storage re-specified in before it is reported
+const respecifiedFrames = 3
+
+// Buffers whose storage is allocated again frame after frame, rather than allocated once and updated
+type bufferRespecification struct {
+	// the buffers that have storage, and the finding of each one re-specified so far, with the frames it was in
+	allocated map[string]bool
+	buffers   map[string]*respecified
+}
+
+type respecified struct {
+	finding *Finding
+	frames  map[int]bool
+}
+
+func newBufferRespecification() *bufferRespecification {
+	return &bufferRespecification{allocated: map[string]bool{}, buffers: map[string]*respecified{}}
+}
+
+func (a *bufferRespecification) Name() string {
+	return "bufferRespecification"
+}
+
+func (a *bufferRespecification) Call(frame int, call *parsers.Call, tracker *state.Tracker) {
+	var buffer string
+
+	switch parsers.Vendorless(call.FunctionName) {
+	case "glBufferData":
+		if len(call.Args) == 0 {
+			return
+		}
+
+		buffer = state.Bound(tracker.State(call.Context).Buffers[call.Args[0].Value.Text])
+
+	case "glNamedBufferData":
+		if len(call.Args) == 0 {
+			return
+		}
+
+		buffer = call.Args[0].Value.Text
+
+	case "glDeleteBuffers":
+		if len(call.Args) >= 2 {
+			for _, name := range state.ValueNames(call.Args[1].Value) {
+				delete(a.allocated, name)
+			}
+		}
+
+		return
+
+	default:
+		return
+	}
+
+	if buffer == "0" {
+		return
+	}
+
+	if !a.allocated[buffer] {
+		a.allocated[buffer] = true
+		return
+	}
+
+	found, ok := a.buffers[buffer]
+
+	if !ok {
+		found = &respecified{
+			finding: &Finding{Analyzer: a.Name(), Frame: frame, Function: call.FunctionName, Object: buffer, Calls: []string{}},
+			frames:  map[int]bool{},
+		}
+
+		a.buffers[buffer] = found
+	}
+
+	found.finding.add(call)
+	found.frames[frame] = true
+}
+
+func (a *bufferRespecification) EndFrame(frame int) []*Finding {
+	return nil
+}
+
+func (a *bufferRespecification) Finish() []*Finding {
+	findings := []*Finding{}
+
+	for _, found := range a.buffers {
+		if len(found.frames) < respecifiedFrames {
+			continue
+		}
+
+		finding := found.finding
+
+		finding.Severity = Medium
+		finding.Explanation = fmt.Sprintf("Buffer %s had its storage re-specified %s over %d frames. "+
+			"Allocating a buffer again makes the driver orphan its old storage or wait for the GPU to finish with it; "+
+			"allocate it once, and update it with glBufferSubData or through a persistent mapping",
+			finding.Object, plural(finding.Count, "time"), len(found.frames))
+
+		findings = append(findings, finding)
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Frame != findings[j].Frame {
+			return findings[i].Frame < findings[j].Frame
+		}
+
+		return findings[i].Object < findings[j].Object
+	})
+
+	return findings
+}
@@ -0,0 +1,278 @@
+package lint
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/state"
+	"strings"
+)
+
+const (
+	// redundant calls to a function in one frame before they matter more than a little
+	redundantCallsLimit = 100
+
+	// program switches in one frame before they are reported
+	programSwitchLimit = 50
+
+	// draws of fewer vertices than this are tiny, and a frame is reported once it makes tinyDrawLimit of them
+	tinyDrawVertices = 32
+	tinyDrawLimit    = 20
+)
+
+// Calls that set state to the value it already has
+type redundantState struct {
+	found *perFunction
+}
+
+func newRedundantState() *redundantState {
+	return &redundantState{found: newPerFunction("redundantState")}
+}
+
+func (a *redundantState) Name() string {
+	return "redundantState"
+}
+
+func (a *redundantState) Call(frame int, call *parsers.Call, tracker *state.Tracker) {
+	if tracker.Redundant(call) {
+		a.found.add(frame, call)
+	}
+}
+
+func (a *redundantState) EndFrame(frame int) []*Finding {
+	return a.found.flush(func(finding *Finding) {
+		finding.Severity = Low
+
+		if finding.Count >= redundantCallsLimit {
+			finding.Severity = Medium
+		}
+
+		finding.Explanation = fmt.Sprintf("%s to %s set state to the value it already had. Each one still "+
+			"costs a call into the driver, and some drivers revalidate the state regardless; keep track of what is "+
+			"set and skip the call", plural(finding.Count, "call"), finding.Function)
+	})
+}
+
+func (a *redundantState) Finish() []*Finding {
+	return nil
+}
+
+// Calls that wait for GL to catch up, by reading state or errors back, or waiting for rendering to finish
+type roundTrips struct {
+	found *perFunction
+}
+
+func newRoundTrips() *roundTrips {
+	return &roundTrips{found: newPerFunction("roundTrips")}
+}
+
+func (a *roundTrips) Name() string {
+	return "roundTrips"
+}
+
+func (a *roundTrips) Call(frame int, call *parsers.Call, tracker *state.Tracker) {
+	function := parsers.Vendorless(call.FunctionName)
+
+	switch {
+	case strings.HasPrefix(function, "glGet"), function == "glFinish":
+		a.found.add(frame, call)
+
+	// reading into a pixel pack buffer doesn't wait for the pixels
+	case function == "glReadPixels" && state.Bound(tracker.State(call.Context).Buffers["GL_PIXEL_PACK_BUFFER"]) == "0":
+		a.found.add(frame, call)
+	}
+}
+
+func (a *roundTrips) EndFrame(frame int) []*Finding {
+	return a.found.flush(func(finding *Finding) {
+		switch parsers.Vendorless(finding.Function) {
+		case "glFinish", "glReadPixels":
+			finding.Severity = High
+			finding.Explanation = fmt.Sprintf("%s was called %s in the frame. It waits for the GPU to finish "+
+				"rendering everything before it; read pixels back into a pixel pack buffer, and map it a frame or "+
+				"two later", finding.Function, plural(finding.Count, "time"))
+
+		case "glGetError":
+			finding.Severity = Medium
+			finding.Explanation = fmt.Sprintf("glGetError was called %s in the frame. Each call waits for the "+
+				"driver to process every call before it; check for errors with debug output in development builds "+
+				"instead", plural(finding.Count, "time"))
+
+		default:
+			finding.Severity = Medium
+			finding.Explanation = fmt.Sprintf("%s was called %s in the frame. Reading state back from GL "+
+				"waits for the driver to process every call before it; keep a copy of the state in the application, "+
+				"or query it once while loading", finding.Function, plural(finding.Count, "time"))
+		}
+	})
+}
+
+func (a *roundTrips) Finish() []*Finding {
+	return nil
+}
+
+// Texture data uploaded once the frame has started drawing
+type midFrameUploads struct {
+	found   *perFunction
+	drawing bool
+}
+
+func newMidFrameUploads() *midFrameUploads {
+	return &midFrameUploads{found: newPerFunction("midFrameUploads")}
+}
+
+func (a *midFrameUploads) Name() string {
+	return "midFrameUploads"
+}
+
+func (a *midFrameUploads) Call(frame int, call *parsers.Call, tracker *state.Tracker) {
+	if parsers.IsDrawCall(call.FunctionName) {
+		a.drawing = true
+		return
+	}
+
+	if _, ok := parsers.TextureUpload(call); ok && a.drawing {
+		a.found.add(frame, call)
+	}
+}
+
+func (a *midFrameUploads) EndFrame(frame int) []*Finding {
+	a.drawing = false
+
+	return a.found.flush(func(finding *Finding) {
+		finding.Severity = Medium
+		finding.Explanation = fmt.Sprintf("%s to %s uploaded texture data after the frame had started drawing. "+
+			"Uploading into a texture the GPU may still be reading makes the driver copy it or wait; upload at the "+
+			"start of the frame, or into textures the frame has not used yet", plural(finding.Count, "call"),
+			finding.Function)
+	})
+}
+
+func (a *midFrameUploads) Finish() []*Finding {
+	return nil
+}
+
+// Frames that switch programs many times
+type programSwitches struct {
+	finding *Finding
+	draws   int
+}
+
+func newProgramSwitches() *programSwitches {
+	return &programSwitches{}
+}
+
+func (a *programSwitches) Name() string {
+	return "programSwitches"
+}
+
+func (a *programSwitches) Call(frame int, call *parsers.Call, tracker *state.Tracker) {
+	if parsers.IsDrawCall(call.FunctionName) {
+		a.draws++
+		return
+	}
+
+	switch parsers.Vendorless(call.FunctionName) {
+	case "glUseProgram", "glUseProgramObject", "glBindProgramPipeline":
+		if tracker.Redundant(call) {
+			return
+		}
+
+		if a.finding == nil {
+			a.finding = &Finding{Analyzer: a.Name(), Frame: frame, Function: call.FunctionName, Calls: []string{}}
+		}
+
+		a.finding.add(call)
+	}
+}
+
+func (a *programSwitches) EndFrame(frame int) []*Finding {
+	finding, draws := a.finding, a.draws
+
+	a.finding, a.draws = nil, 0
+
+	if finding == nil || finding.Count < programSwitchLimit {
+		return nil
+	}
+
+	finding.Severity = Medium
+	finding.Explanation = fmt.Sprintf("The frame switched programs %d times for %d draws. Each switch makes the "+
+		"driver validate the pipeline again; sort draws by program, or merge programs that only differ in their "+
+		"uniforms", finding.Count, draws)
+
+	return []*Finding{finding}
+}
+
+func (a *programSwitches) Finish() []*Finding {
+	return nil
+}
+
+// Frames that make many draws of only a few vertices
+type tinyDraws struct {
+	finding *Finding
+}
+
+func newTinyDraws() *tinyDraws {
+	return &tinyDraws{}
+}
+
+func (a *tinyDraws) Name() string {
+	return "tinyDraws"
+}
+
+func (a *tinyDraws) Call(frame int, call *parsers.Call, tracker *state.Tracker) {
+	count := call.Arg("count")
+
+	if !parsers.IsDrawCall(call.FunctionName) || count == nil || strings.HasPrefix(call.FunctionName, "glMultiDraw") {
+		return
+	}
+
+	vertices, ok := count.Integer()
+
+	if !ok {
+		return
+	}
+
+	if instances := call.Arg("instancecount"); instances != nil {
+		n, _ := instances.Integer()
+		vertices *= n
+	}
+
+	if vertices >= tinyDrawVertices {
+		return
+	}
+
+	if a.finding == nil {
+		a.finding = &Finding{Analyzer: a.Name(), Frame: frame, Calls: []string{}}
+	}
+
+	a.finding.add(call)
+}
+
+func (a *tinyDraws) EndFrame(frame int) []*Finding {
+	finding := a.finding
+
+	a.finding = nil
+
+	if finding == nil || finding.Count < tinyDrawLimit {
+		return nil
+	}
+
+	finding.Severity = Low
+	finding.Explanation = fmt.Sprintf("%d draws in the frame drew fewer than %d vertices each. Most of the cost of a "+
+		"draw is the same whatever its size, so small draws are bound by the CPU; batch them into fewer, larger "+
+		"draws, or draw them instanced", finding.Count, tinyDrawVertices)
+
+	return []*Finding{finding}
+}
+
+func (a *tinyDraws) Finish() []*Finding {
+	return nil
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+
+	return fmt.Sprintf("%d %ss", n, word)
+}
@@ -0,0 +1,188 @@
+// Package lint looks through a trace for the ways of using GL that commonly cost performance, such as setting state
+// that is already set or reading state back in the middle of a frame. It follows the trace a frame at a time, so it can
+// run while the trace is dumped
+package lint
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/state"
+	"sort"
+)
+
+const (
+	High   = "high"
+	Medium = "medium"
+	Low    = "low"
+)
+
+// the most calls a finding names; Count has how many there were
+const maxCalls = 20
+
+// Something an analyzer found, in a frame or, for what builds up over several frames, starting at one. Calls are the
+// first of the calls it found it at
+type Finding struct {
+	Analyzer    string   `json:"analyzer"`
+	Severity    string   `json:"severity"`
+	Frame       int      `json:"frame"`
+	Function    string   `json:"function,omitempty"`
+	Object      string   `json:"object,omitempty"`
+	Count       int      `json:"count"`
+	Calls       []string `json:"calls"`
+	Explanation string   `json:"explanation"`
+}
+
+// The findings of every analyzer, in frame order, with how many each analyzer made
+type Report struct {
+	Findings []*Finding     `json:"findings"`
+	Counts   map[string]int `json:"counts"`
+}
+
+// An analyzer is shown every call of a trace in order, alongside the state of the calls before it, and reports what it
+// found at the end of each frame and of the trace
+type Analyzer interface {
+	Name() string
+	Call(frame int, call *parsers.Call, tracker *state.Tracker)
+	EndFrame(frame int) []*Finding
+	Finish() []*Finding
+}
+
+// Every analyzer there is
+func Analyzers() []Analyzer {
+	return []Analyzer{
+		newRedundantState(),
+		newRoundTrips(),
+		newBufferRespecification(),
+		newMidFrameUploads(),
+		newProgramSwitches(),
+		newTinyDraws(),
+	}
+}
+
+// Runs analyzers over a trace a frame at a time
+type Linter struct {
+	state     *state.Tracker
+	analyzers []Analyzer
+	report    *Report
+}
+
+// A linter running the analyzers given, or every analyzer when none are, against the state tracker given. The linter
+// is shown each call before the state tracker applies it
+func NewLinter(tracker *state.Tracker, analyzers ...Analyzer) *Linter {
+	if len(analyzers) == 0 {
+		analyzers = Analyzers()
+	}
+
+	return &Linter{
+		state:     tracker,
+		analyzers: analyzers,
+		report:    &Report{Findings: []*Finding{}, Counts: map[string]int{}},
+	}
+}
+
+// Show a call of a frame to every analyzer
+func (l *Linter) Call(frame int, call *parsers.Call) {
+	for _, analyzer := range l.analyzers {
+		analyzer.Call(frame, call, l.state)
+	}
+}
+
+// Collect what every analyzer found in a frame
+func (l *Linter) EndFrame(frame int) {
+	for _, analyzer := range l.analyzers {
+		l.add(analyzer, analyzer.EndFrame(frame))
+	}
+}
+
+// The findings about the frames added so far
+func (l *Linter) Report() *Report {
+	report := &Report{Findings: append([]*Finding{}, l.report.Findings...), Counts: map[string]int{}}
+
+	for analyzer, count := range l.report.Counts {
+		report.Counts[analyzer] = count
+	}
+
+	for _, analyzer := range l.analyzers {
+		findings := analyzer.Finish()
+
+		report.Findings = append(report.Findings, findings...)
+		report.Counts[analyzer.Name()] += len(findings)
+	}
+
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return report.Findings[i].Frame < report.Findings[j].Frame
+	})
+
+	return report
+}
+
+func (l *Linter) add(analyzer Analyzer, findings []*Finding) {
+	l.report.Findings = append(l.report.Findings, findings...)
+	l.report.Counts[analyzer.Name()] += len(findings)
+}
+
+// Run every analyzer over a parsed trace
+func Analyze(td *parsers.TraceDump) *Report {
+	tracker := state.NewTracker()
+	linter := NewLinter(tracker)
+
+	for _, frame := range td.Frames {
+		for _, call := range frame.Calls {
+			linter.Call(frame.ID, call)
+			tracker.AddCall(call)
+		}
+
+		linter.EndFrame(frame.ID)
+	}
+
+	return linter.Report()
+}
+
+func (f *Finding) add(call *parsers.Call) {
+	f.Count++
+
+	if len(f.Calls) < maxCalls {
+		f.Calls = append(f.Calls, call.ID)
+	}
+}
+
+// The findings of a frame, one for each function they were found at, in the order they were first found
+type perFunction struct {
+	analyzer string
+	findings map[string]*Finding
+	order    []string
+}
+
+func newPerFunction(analyzer string) *perFunction {
+	return &perFunction{analyzer: analyzer, findings: map[string]*Finding{}}
+}
+
+func (p *perFunction) add(frame int, call *parsers.Call) {
+	finding, ok := p.findings[call.FunctionName]
+
+	if !ok {
+		finding = &Finding{Analyzer: p.analyzer, Frame: frame, Function: call.FunctionName, Calls: []string{}}
+
+		p.findings[call.FunctionName] = finding
+		p.order = append(p.order, call.FunctionName)
+	}
+
+	finding.add(call)
+}
+
+// Hand over the findings of the frame, letting explain set the severity and explanation of each, and start afresh
+func (p *perFunction) flush(explain func(*Finding)) []*Finding {
+	findings := make([]*Finding, 0, len(p.order))
+
+	for _, function := range p.order {
+		finding := p.findings[function]
+
+		explain(finding)
+
+		findings = append(findings, finding)
+	}
+
+	p.findings = map[string]*Finding{}
+	p.order = nil
+
+	return findings
+}
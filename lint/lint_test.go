@@ -0,0 +1,291 @@
+package lint
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	blend      = "glEnable(cap = GL_BLEND)"
+	draw       = "glDrawArrays(mode = GL_TRIANGLES, first = 0, count = 36)"
+	tinyDraw   = "glDrawArrays(mode = GL_TRIANGLES, first = 0, count = 3)"
+	bufferData = "glBufferData(target = GL_ARRAY_BUFFER, size = 1024, data = NULL, usage = GL_DYNAMIC_DRAW)"
+	upload     = "glTexSubImage2D(target = GL_TEXTURE_2D, level = 0, xoffset = 0, yoffset = 0, width = 4, height = 4, " +
+		"format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = blob(64))"
+)
+
+func TestAnalyzers(t *testing.T) {
+	tests := []struct {
+		name     string
+		analyzer string
+		frames   [][]string
+
+		// each finding as its severity, frame, function, object and count
+		findings []string
+	}{
+		// the first call sets state the trace hasn't set yet, so only the repeats are redundant
+		{
+			name:     "redundant state under the limit",
+			analyzer: "redundantState",
+			frames:   [][]string{repeat(redundantCallsLimit, blend)},
+			findings: []string{fmt.Sprintf("low in frame 0 glEnable x%d", redundantCallsLimit-1)},
+		},
+		{
+			name:     "redundant state at the limit",
+			analyzer: "redundantState",
+			frames:   [][]string{repeat(redundantCallsLimit+1, blend)},
+			findings: []string{fmt.Sprintf("medium in frame 0 glEnable x%d", redundantCallsLimit)},
+		},
+		{
+			name:     "redundant state per function and frame",
+			analyzer: "redundantState",
+			frames: [][]string{
+				{blend, "glDisable(cap = GL_BLEND)", "glDisable(cap = GL_BLEND)", "glUseProgram(program = 0)"},
+				{"glDisable(cap = GL_BLEND)", "glUseProgram(program = 3)", "glUseProgram(program = 3)"},
+			},
+			findings: []string{
+				"low in frame 0 glDisable x1",
+				"low in frame 0 glUseProgram x1",
+				"low in frame 1 glDisable x1",
+				"low in frame 1 glUseProgram x1",
+			},
+		},
+		{
+			// only the functions that set uniforms are compared with the uniform's value, and only with the value the
+			// same function wrote
+			name:     "redundant uniforms",
+			analyzer: "redundantState",
+			frames: [][]string{{
+				"glUseProgram(program = 3)",
+				"glUniform1i(location = 0, v0 = 1)",
+				"glUniform1i(location = 0, v0 = 1)",
+				"glUniform1i(location = 0, v0 = 2)",
+				"glUniformBlockBinding(program = 3, uniformBlockIndex = 0, uniformBlockBinding = 1)",
+				"glUniformBlockBinding(program = 3, uniformBlockIndex = 0, uniformBlockBinding = 1)",
+				"glProgramUniform1i(program = 3, location = 0, v0 = 2)",
+				"glProgramUniform1i(program = 3, location = 0, v0 = 2)",
+			}},
+			findings: []string{
+				"low in frame 0 glUniform1i x1",
+				"low in frame 0 glProgramUniform1i x1",
+			},
+		},
+
+		{
+			name:     "program switches under the limit",
+			analyzer: "programSwitches",
+			frames:   [][]string{switches(programSwitchLimit - 1)},
+		},
+		{
+			name:     "program switches at the limit",
+			analyzer: "programSwitches",
+			frames:   [][]string{switches(programSwitchLimit)},
+			findings: []string{fmt.Sprintf("medium in frame 0 glUseProgram x%d", programSwitchLimit)},
+		},
+		{
+			// using the program already in use isn't a switch
+			name:     "program switches without redundant ones",
+			analyzer: "programSwitches",
+			frames:   [][]string{append(switches(programSwitchLimit-1), "glUseProgram(program = 3)")},
+		},
+
+		{
+			name:     "tiny draws under the limit",
+			analyzer: "tinyDraws",
+			frames:   [][]string{repeat(tinyDrawLimit-1, tinyDraw)},
+		},
+		{
+			name:     "tiny draws at the limit",
+			analyzer: "tinyDraws",
+			frames:   [][]string{repeat(tinyDrawLimit, tinyDraw)},
+			findings: []string{fmt.Sprintf("low in frame 0 x%d", tinyDrawLimit)},
+		},
+		{
+			// draws are tiny by the vertices of every instance, and multi-draws aren't counted
+			name:     "draws of enough vertices",
+			analyzer: "tinyDraws",
+			frames: [][]string{append(append(
+				repeat(tinyDrawLimit, fmt.Sprintf("glDrawArrays(mode = GL_TRIANGLES, first = 0, count = %d)", tinyDrawVertices)),
+				repeat(tinyDrawLimit, "glDrawArraysInstanced(mode = GL_TRIANGLES, first = 0, count = 8, instancecount = 4)")...),
+				repeat(tinyDrawLimit, "glMultiDrawArrays(mode = GL_TRIANGLES, first = {0, 3}, count = {3, 3}, drawcount = 2)")...),
+			},
+		},
+		{
+			name:     "tiny draws per frame",
+			analyzer: "tinyDraws",
+			frames:   [][]string{repeat(tinyDrawLimit/2, tinyDraw), repeat(tinyDrawLimit/2, tinyDraw)},
+		},
+
+		{
+			name:     "buffers re-specified in too few frames",
+			analyzer: "bufferRespecification",
+			frames:   respecifications(respecifiedFrames),
+		},
+		{
+			// the first allocation is not a re-specification, and the finding starts at the first one that is
+			name:     "buffers re-specified in enough frames",
+			analyzer: "bufferRespecification",
+			frames:   respecifications(respecifiedFrames + 1),
+			findings: []string{fmt.Sprintf("medium in frame 1 glBufferData buffer 1 x%d", respecifiedFrames)},
+		},
+		{
+			// deleting a buffer and allocating its name again is a new buffer
+			name:     "buffers deleted and allocated again",
+			analyzer: "bufferRespecification",
+			frames: [][]string{
+				{"glNamedBufferData(buffer = 2, size = 16, data = NULL, usage = GL_STATIC_DRAW)"},
+				{"glDeleteBuffers(n = 1, buffers = &2)", "glNamedBufferData(buffer = 2, size = 16, data = NULL, usage = GL_STATIC_DRAW)"},
+				{"glDeleteBuffers(n = 1, buffers = &2)", "glNamedBufferData(buffer = 2, size = 16, data = NULL, usage = GL_STATIC_DRAW)"},
+				{"glDeleteBuffers(n = 1, buffers = &2)", "glNamedBufferData(buffer = 2, size = 16, data = NULL, usage = GL_STATIC_DRAW)"},
+				{bufferData},
+			},
+		},
+
+		{
+			name:     "round trips",
+			analyzer: "roundTrips",
+			frames: [][]string{
+				{
+					"glGetError() = GL_NO_ERROR",
+					"glGetIntegerv(pname = GL_VIEWPORT, data = {0, 0, 800, 600})",
+					"glGetError() = GL_NO_ERROR",
+					"glFinish()",
+					"glReadPixels(x = 0, y = 0, width = 1, height = 1, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = blob(4))",
+				},
+				{
+					// reading into a pixel pack buffer doesn't wait
+					"glBindBuffer(target = GL_PIXEL_PACK_BUFFER, buffer = 5)",
+					"glReadPixels(x = 0, y = 0, width = 1, height = 1, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)",
+				},
+			},
+			findings: []string{
+				"medium in frame 0 glGetError x2",
+				"medium in frame 0 glGetIntegerv x1",
+				"high in frame 0 glFinish x1",
+				"high in frame 0 glReadPixels x1",
+			},
+		},
+
+		{
+			// uploads before the first draw of each frame are fine
+			name:     "mid-frame uploads",
+			analyzer: "midFrameUploads",
+			frames: [][]string{
+				{upload, draw, upload, upload},
+				{upload, draw},
+				{draw, "glCompressedTexSubImage2D(target = GL_TEXTURE_2D, level = 0, xoffset = 0, yoffset = 0, " +
+					"width = 4, height = 4, format = GL_COMPRESSED_RGBA_S3TC_DXT5_EXT, imageSize = 16, data = blob(16))"},
+			},
+			findings: []string{
+				"medium in frame 0 glTexSubImage2D x2",
+				"medium in frame 2 glCompressedTexSubImage2D x1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := Analyze(parsers.ParseDump(dump(test.frames)))
+
+			findings := []string{}
+
+			for _, finding := range report.Findings {
+				if finding.Analyzer != test.analyzer {
+					continue
+				}
+
+				findings = append(findings, describe(finding))
+
+				if len(finding.Calls) > maxCalls || len(finding.Calls) > finding.Count {
+					t.Errorf("expected at most %d of the %d calls to be named, got %d", maxCalls, finding.Count,
+						len(finding.Calls))
+				}
+
+				if len(finding.Explanation) == 0 {
+					t.Errorf("expected the finding to be explained")
+				}
+			}
+
+			if !reflect.DeepEqual(findings, nonNil(test.findings)) {
+				t.Errorf("expected the findings\n%s\ngot\n%s", strings.Join(test.findings, "\n"), strings.Join(findings, "\n"))
+			}
+
+			if report.Counts[test.analyzer] != len(test.findings) {
+				t.Errorf("expected %d findings to be counted, got %d", len(test.findings), report.Counts[test.analyzer])
+			}
+		})
+	}
+}
+
+// number the calls of the frames, ending each with a swap
+func dump(frames [][]string) string {
+	var b strings.Builder
+
+	number := 0
+
+	for _, calls := range frames {
+		for _, call := range append(calls, "glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)") {
+			fmt.Fprintf(&b, "%d %s\n", number, call)
+			number++
+		}
+	}
+
+	return b.String()
+}
+
+func repeat(n int, call string) []string {
+	calls := make([]string, n)
+
+	for i := range calls {
+		calls[i] = call
+	}
+
+	return calls
+}
+
+// switch between two programs the number of times given, drawing once with each
+func switches(n int) []string {
+	var calls []string
+
+	for i := 0; i < n; i++ {
+		calls = append(calls, fmt.Sprintf("glUseProgram(program = %d)", 3+i%2), draw)
+	}
+
+	return calls
+}
+
+// allocate a buffer's storage once in each of the number of frames given
+func respecifications(frames int) [][]string {
+	calls := [][]string{{"glBindBuffer(target = GL_ARRAY_BUFFER, buffer = 1)", bufferData}}
+
+	for i := 1; i < frames; i++ {
+		calls = append(calls, []string{bufferData})
+	}
+
+	return calls
+}
+
+func describe(f *Finding) string {
+	parts := []string{f.Severity, "in frame", fmt.Sprint(f.Frame)}
+
+	if len(f.Function) > 0 {
+		parts = append(parts, f.Function)
+	}
+
+	if len(f.Object) > 0 {
+		parts = append(parts, "buffer", f.Object)
+	}
+
+	return strings.Join(append(parts, fmt.Sprintf("x%d", f.Count)), " ")
+}
+
+func nonNil(findings []string) []string {
+	if findings == nil {
+		return []string{}
+	}
+
+	return findings
+}
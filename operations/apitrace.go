@@ -0,0 +1,196 @@
+package operations
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/tracefile"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	GL    = "gl"
+	EGL   = "egl"
+	GLES1 = "gles1"
+	GLES2 = "gles2"
+)
+
+// How apitrace is loaded into the traced app: preloaded by `apitrace trace`, or in wrapper mode, standing in for libGL
+// on the library path, see traceWithWrapper
+const (
+	Preload = "preload"
+	Wrapper = "wrapper"
+)
+
+// The apitrace backend, driving `apitrace trace`, `apitrace dump`, `apitrace dump-images` and glretrace, or eglretrace
+// for EGL and GLES applications
+type APITrace struct {
+	APITraceLocation   string
+	GLRetraceLocation  string
+	EGLRetraceLocation string
+	API                string
+	TraceMode          string
+}
+
+// Check an app's API is one apitrace can trace; empty means desktop GL
+func ValidateAPI(api string) error {
+	switch api {
+	case "", GL, EGL, GLES1, GLES2:
+		return nil
+	default:
+		return fmt.Errorf("unknown API <%s>, expected one of %s, %s, %s or %s", api, GL, EGL, GLES1, GLES2)
+	}
+}
+
+// Check an app's trace mode is one it can be traced with; empty means preload. Wrapper mode only stands in for libGL,
+// so it is for desktop GL apps
+func ValidateTraceMode(mode, api string) error {
+	switch mode {
+	case "", Preload:
+		return nil
+	case Wrapper:
+		if TraceAPI(api) != GL {
+			return fmt.Errorf("%s mode only traces %s apps, %s apps have to be preloaded", Wrapper, GL, api)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown trace mode <%s>, expected %s or %s", mode, Preload, Wrapper)
+	}
+}
+
+// The value for `apitrace trace --api`; GLES contexts are always created through EGL, so they use the EGL wrapper
+func TraceAPI(api string) string {
+	switch api {
+	case EGL, GLES1, GLES2:
+		return EGL
+	default:
+		return GL
+	}
+}
+
+// EGL and GLES traces have to be replayed with eglretrace: the one given, or when none is, the one installed alongside
+// glretrace
+func RetraceLocation(glretraceLocation, eglretraceLocation, api string) string {
+	if TraceAPI(api) != EGL {
+		return glretraceLocation
+	}
+
+	if len(eglretraceLocation) > 0 {
+		return eglretraceLocation
+	}
+
+	return filepath.Join(filepath.Dir(glretraceLocation), "eglretrace")
+}
+
+// eglretrace is optional, as only EGL and GLES apps need it, but has to be runnable when it is given
+func (a *APITrace) Validate() error {
+	if err := ValidateBinary(a.APITraceLocation); err != nil {
+		return err
+	}
+
+	if len(a.EGLRetraceLocation) > 0 {
+		if err := ValidateBinary(a.EGLRetraceLocation); err != nil {
+			return err
+		}
+	}
+
+	return ValidateBinary(a.GLRetraceLocation)
+}
+
+func (a *APITrace) Versions(workingDirectory string) (string, string) {
+	retraceLocation := RetraceLocation(a.GLRetraceLocation, a.EGLRetraceLocation, a.API)
+
+	return APITraceVersion(workingDirectory, a.APITraceLocation), RetraceVersion(workingDirectory, retraceLocation)
+}
+
+func (a *APITrace) Capabilities(workingDirectory string) Capabilities {
+	return ProbeCapabilities(workingDirectory, a.APITraceLocation, a.GLRetraceLocation, a.EGLRetraceLocation)
+}
+
+func (a *APITrace) Capture(workingDirectory, executable string, timeout int) (string, string, string, error) {
+
+	stdout, stderr, err := Trace(workingDirectory, a.APITraceLocation, executable, a.API, a.TraceMode, timeout)
+
+	if err != nil {
+		return "", stdout, stderr, err
+	}
+
+	return traceFileFromStderr(stderr), stdout, stderr, nil
+}
+
+// The trace is read directly where possible; `apitrace dump` is only needed for traces the tracefile package cannot
+// read, such as ones compressed with a newer scheme
+func (a *APITrace) Dump(workingDirectory, traceFile string, handle parsers.FrameHandler) (int, string, error) {
+
+	tracePath := traceFile
+
+	if !filepath.IsAbs(tracePath) {
+		tracePath = filepath.Join(workingDirectory, tracePath)
+	}
+
+	if reader, err := tracefile.Open(tracePath); err == nil {
+		reader.Close()
+
+		frames, err := tracefile.StreamFrames(tracePath, handle)
+
+		return frames, "", err
+	}
+
+	frames := 0
+
+	stderr, err := Dump(workingDirectory, a.APITraceLocation, traceFile, func(stdout io.Reader) error {
+		var err error
+
+		frames, err = parsers.StreamDump(stdout, handle)
+
+		return err
+	})
+
+	return frames, stderr, err
+}
+
+func (a *APITrace) StateSnapshot(workingDirectory, traceFile, callID string) (string, string, error) {
+	return Retrace(workingDirectory, RetraceLocation(a.GLRetraceLocation, a.EGLRetraceLocation, a.API), traceFile, callID)
+}
+
+func (a *APITrace) DumpImages(workingDirectory, traceFile, callID string) (*parsers.ImageSet, string, string, error) {
+
+	stdout, stderr, err := DumpImages(workingDirectory, a.APITraceLocation, traceFile, callID)
+
+	if err != nil {
+		return nil, stdout, stderr, err
+	}
+
+	return parsers.ParseImageDumpFile(stdout), stdout, stderr, nil
+}
+
+func (a *APITrace) Leaks(workingDirectory, traceFile string) (string, string, error) {
+	return Leaks(workingDirectory, a.APITraceLocation, traceFile)
+}
+
+// apitrace announces where it is writing the trace on stderr, e.g. "apitrace: tracing to /tmp/app/main.trace"
+func traceFileFromStderr(traceStdErr string) string {
+
+	traceFilePath := ""
+	scanner := bufio.NewScanner(strings.NewReader(traceStdErr))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		stringFields := strings.Fields(line)
+
+		if strings.HasPrefix(line, "apitrace:") {
+			if strings.Contains(line, "tracing to") {
+				traceFilePath = stringFields[len(stringFields)-1]
+				break
+			}
+		}
+
+	}
+
+	return traceFilePath
+
+}
@@ -0,0 +1,61 @@
+package operations
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+)
+
+const (
+	APITraceBackend       = "apitrace"
+	GFXReconstructBackend = "gfxreconstruct"
+)
+
+// A Backend is a tracing tool and its replayer; every stage of the pipeline after the build goes through one
+type Backend interface {
+	// Check that the backend's binaries exist and are executable
+	Validate() error
+
+	// The versions reported by the tracer and the replayer
+	Versions(workingDirectory string) (string, string)
+
+	// The optional features the installed tools support
+	Capabilities(workingDirectory string) Capabilities
+
+	// Run the executable under the tracer for timeout seconds, returning the trace file written along with the
+	// tracer's stdout and stderr
+	Capture(workingDirectory, executable string, timeout int) (string, string, string, error)
+
+	// Convert a trace file into the calls made in each frame, handing each frame to handle as soon as it has been
+	// parsed. Returns the number of frames handled and the converter's stderr
+	Dump(workingDirectory, traceFile string, handle parsers.FrameHandler) (int, string, error)
+
+	// Replay the trace up to callID and return the state at that call as JSON, along with the replayer's stderr
+	StateSnapshot(workingDirectory, traceFile, callID string) (string, string, error)
+
+	// Replay the trace up to callID and write out the bound framebuffer images
+	DumpImages(workingDirectory, traceFile, callID string) (*parsers.ImageSet, string, string, error)
+
+	// Check the trace for objects it never deleted with the tracer's own leak checker, returning its stdout and stderr
+	Leaks(workingDirectory, traceFile string) (string, string, error)
+}
+
+// Create the backend for a toolchain; an unknown or empty kind means apitrace, which replays EGL and GLES traces with
+// eglRetracer. The API is the one the app renders with, see ValidateAPI, and the trace mode how apitrace is loaded into
+// it, see ValidateTraceMode
+func NewBackend(kind, tracer, converter, retracer, eglRetracer, api, traceMode string) Backend {
+	switch kind {
+	case GFXReconstructBackend:
+		return &GFXReconstruct{
+			CaptureLocation: tracer,
+			ConvertLocation: converter,
+			ReplayLocation:  retracer,
+		}
+	default:
+		return &APITrace{
+			APITraceLocation:   tracer,
+			GLRetraceLocation:  retracer,
+			EGLRetraceLocation: eglRetracer,
+			API:                api,
+			TraceMode:          traceMode,
+		}
+	}
+}
@@ -2,14 +2,25 @@ package operations
 
 import (
 	"bytes"
+	"io"
+	"os"
 	"os/exec"
 )
 
 func execute(workingDirectory, command string, arguments []string) (string, string, error) {
+	return executeWithEnvironment(workingDirectory, command, arguments, nil)
+}
+
+// Run a command with extra KEY=VALUE environment variables on top of the server's own environment
+func executeWithEnvironment(workingDirectory, command string, arguments, environment []string) (string, string, error) {
 
 	cmd := exec.Command(command, arguments...)
 	cmd.Dir = workingDirectory
 
+	if len(environment) > 0 {
+		cmd.Env = append(os.Environ(), environment...)
+	}
+
 	var stderrStr bytes.Buffer
 	cmd.Stderr = &stderrStr
 
@@ -26,3 +37,36 @@ func execute(workingDirectory, command string, arguments []string) (string, stri
 
 	return stdoutStr.String(), stderrStr.String(), nil
 }
+
+// Run a command and hand its stdout to consume as it is written, rather than collecting it all in memory. If consume
+// fails the command is killed, since nothing would be reading the rest of its output
+func executeStreaming(workingDirectory, command string, arguments []string, consume func(io.Reader) error) (string, error) {
+
+	cmd := exec.Command(command, arguments...)
+	cmd.Dir = workingDirectory
+
+	var stderrStr bytes.Buffer
+	cmd.Stderr = &stderrStr
+
+	stdout, err := cmd.StdoutPipe()
+
+	if err != nil {
+		return "", err
+	}
+
+	err = cmd.Start()
+
+	if err != nil {
+		return "", err
+	}
+
+	err = consume(stdout)
+
+	if err != nil {
+		cmd.Process.Kill()
+	}
+
+	cmd.Wait()
+
+	return stderrStr.String(), err
+}
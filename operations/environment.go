@@ -0,0 +1,113 @@
+package operations
+
+import (
+	"bufio"
+	"io/ioutil"
+	"strings"
+)
+
+type Environment struct {
+	APITraceVersion string `json:"apiTraceVersion"`
+	RetraceVersion  string `json:"retraceVersion"`
+	GLVendor        string `json:"glVendor"`
+	GLRenderer      string `json:"glRenderer"`
+	GLVersion       string `json:"glVersion"`
+	Kernel          string `json:"kernel"`
+	Distro          string `json:"distro"`
+	Hostname        string `json:"hostname"`
+}
+
+// Collect the versions of the tracing tools and details of the host that a trace is being produced on; the GL strings
+// are filled in later from the dump
+func CollectEnvironment(workingDirectory string, backend Backend) *Environment {
+
+	env := new(Environment)
+
+	env.APITraceVersion, env.RetraceVersion = backend.Versions(workingDirectory)
+
+	kernel, _, err := execute(workingDirectory, "uname", []string{"-srm"})
+
+	if err == nil {
+		env.Kernel = strings.TrimSpace(kernel)
+	}
+
+	hostname, _, err := execute(workingDirectory, "uname", []string{"-n"})
+
+	if err == nil {
+		env.Hostname = strings.TrimSpace(hostname)
+	}
+
+	env.Distro = distro("/etc/os-release")
+
+	return env
+}
+
+// Run `apitrace version`, returning the first line of its output
+func APITraceVersion(workingDirectory, apiTraceLocation string) string {
+
+	if len(apiTraceLocation) == 0 {
+		return ""
+	}
+
+	stdout, stderr, err := execute(workingDirectory, apiTraceLocation, []string{"version"})
+
+	if err != nil {
+		return ""
+	}
+
+	return firstLine(stdout, stderr)
+}
+
+// Run `glretrace --version`, returning the first line of its output
+func RetraceVersion(workingDirectory, glretraceLocation string) string {
+
+	if len(glretraceLocation) == 0 {
+		return ""
+	}
+
+	stdout, stderr, err := execute(workingDirectory, glretraceLocation, []string{"--version"})
+
+	if err != nil {
+		return ""
+	}
+
+	return firstLine(stdout, stderr)
+}
+
+func firstLine(outputs ...string) string {
+	for _, output := range outputs {
+		scanner := bufio.NewScanner(strings.NewReader(output))
+
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+
+			if len(line) > 0 {
+				return line
+			}
+		}
+	}
+
+	return ""
+}
+
+// Read the PRETTY_NAME from an os-release file, e.g. "Ubuntu 18.04.2 LTS"
+func distro(osReleasePath string) string {
+
+	contents, err := ioutil.ReadFile(osReleasePath)
+
+	if err != nil {
+		return ""
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(contents)))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "PRETTY_NAME=") {
+			return strings.Trim(strings.TrimPrefix(line, "PRETTY_NAME="), `"`)
+		}
+	}
+
+	return ""
+}
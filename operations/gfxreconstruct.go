@@ -0,0 +1,213 @@
+package operations
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The GFXReconstruct backend for Vulkan applications, driving gfxrecon-capture.py, gfxrecon-convert and
+// gfxrecon-replay. On machines without a Vulkan driver, Mesa's lavapipe can be used for both capture and replay
+type GFXReconstruct struct {
+	CaptureLocation string
+	ConvertLocation string
+	ReplayLocation  string
+}
+
+func (g *GFXReconstruct) Validate() error {
+	for _, binary := range []string{g.CaptureLocation, g.ConvertLocation, g.ReplayLocation} {
+		if err := ValidateBinary(binary); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *GFXReconstruct) Versions(workingDirectory string) (string, string) {
+	return gfxreconVersion(workingDirectory, g.ConvertLocation), gfxreconVersion(workingDirectory, g.ReplayLocation)
+}
+
+// gfxrecon-replay can take screenshots of frames, but there is no state dump to choose a format for
+func (g *GFXReconstruct) Capabilities(workingDirectory string) Capabilities {
+	return Capabilities{
+		DumpImages: true,
+	}
+}
+
+func (g *GFXReconstruct) Capture(workingDirectory, executable string, timeout int) (string, string, string, error) {
+
+	traceFile := fmt.Sprintf("%s.gfxr", filepath.Base(executable))
+
+	args := []string{
+		fmt.Sprintf("%ds", timeout),
+		g.CaptureLocation,
+		"-o",
+		traceFile,
+		"--no-file-timestamp",
+		fmt.Sprintf("./%s", executable),
+	}
+
+	stdout, stderr, err := execute(workingDirectory, "timeout", args)
+
+	if err != nil {
+		return "", stdout, stderr, err
+	}
+
+	return traceFile, stdout, stderr, nil
+}
+
+// gfxrecon-convert can only write to a file, so the frames are streamed from the converted file once it is complete
+func (g *GFXReconstruct) Dump(workingDirectory, traceFile string, handle parsers.FrameHandler) (int, string, error) {
+
+	jsonFile, stderr, err := g.convert(workingDirectory, traceFile)
+
+	if err != nil {
+		return 0, stderr, err
+	}
+
+	converted, err := os.Open(jsonFile)
+
+	if err != nil {
+		return 0, stderr, err
+	}
+
+	defer converted.Close()
+
+	frames, err := parsers.StreamGFXReconJSON(converted, handle)
+
+	return frames, stderr, err
+}
+
+func (g *GFXReconstruct) StateSnapshot(workingDirectory, traceFile, callID string) (string, string, error) {
+	return "", "", fmt.Errorf("gfxreconstruct cannot snapshot the state at a call")
+}
+
+func (g *GFXReconstruct) Leaks(workingDirectory, traceFile string) (string, string, error) {
+	return "", "", fmt.Errorf("gfxreconstruct has no leak checker")
+}
+
+// gfxrecon-replay only takes screenshots at the end of a frame, so the images are for the frame containing callID
+func (g *GFXReconstruct) DumpImages(workingDirectory, traceFile, callID string) (*parsers.ImageSet, string, string, error) {
+
+	call, err := strconv.Atoi(callID)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	frame, err := g.frameOfCall(workingDirectory, traceFile, call)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	prefix := fmt.Sprintf("screenshot-%s", callID)
+
+	args := []string{
+		"--screenshots",
+		strconv.Itoa(frame),
+		"--screenshot-dir",
+		workingDirectory,
+		"--screenshot-prefix",
+		prefix,
+		traceFile,
+	}
+
+	stdout, stderr, err := execute(workingDirectory, g.ReplayLocation, args)
+
+	if err != nil {
+		return nil, stdout, stderr, err
+	}
+
+	imageSet := &parsers.ImageSet{
+		CallID: callID,
+	}
+
+	screenshots, _ := filepath.Glob(filepath.Join(workingDirectory, fmt.Sprintf("%s_*", prefix)))
+
+	for _, screenshot := range screenshots {
+		name := filepath.Base(screenshot)
+		extension := filepath.Ext(name)
+
+		imageSet.Type = strings.TrimPrefix(extension, ".")
+		imageSet.MRT = append(imageSet.MRT, strings.TrimSuffix(name, extension))
+	}
+
+	return imageSet, stdout, stderr, nil
+}
+
+// Run gfxrecon-convert over the capture, returning the path of the JSON lines file it wrote
+func (g *GFXReconstruct) convert(workingDirectory, traceFile string) (string, string, error) {
+
+	jsonFile := fmt.Sprintf("%s.jsonl", strings.TrimSuffix(traceFile, filepath.Ext(traceFile)))
+
+	args := []string{
+		"--output",
+		jsonFile,
+		traceFile,
+	}
+
+	_, stderr, err := execute(workingDirectory, g.ConvertLocation, args)
+
+	if err != nil {
+		return "", stderr, err
+	}
+
+	if !filepath.IsAbs(jsonFile) {
+		jsonFile = filepath.Join(workingDirectory, jsonFile)
+	}
+
+	return jsonFile, stderr, nil
+}
+
+// gfxrecon-replay numbers frames from 1
+func (g *GFXReconstruct) frameOfCall(workingDirectory, traceFile string, call int) (int, error) {
+
+	found := -1
+
+	_, _, err := g.Dump(workingDirectory, traceFile, func(frame *parsers.Frame) error {
+		for _, c := range frame.Calls {
+			if c.ID == strconv.Itoa(call) {
+				found = frame.ID + 1
+
+				// nothing after this frame is needed
+				return io.EOF
+			}
+		}
+
+		return nil
+	})
+
+	if found > 0 {
+		return found, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("call %d is not in %s", call, traceFile)
+}
+
+func gfxreconVersion(workingDirectory, location string) string {
+
+	stdout, stderr, err := execute(workingDirectory, location, []string{"--version"})
+
+	if err != nil {
+		return ""
+	}
+
+	// gfxrecon tools print a banner line before the version itself
+	for _, line := range strings.Split(stdout+stderr, "\n") {
+		if strings.Contains(line, "GFXReconstruct Version") {
+			return strings.TrimSpace(line)
+		}
+	}
+
+	return firstLine(stdout, stderr)
+}
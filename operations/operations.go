@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"golang.org/x/crypto/ssh"
 	ssh2 "gopkg.in/src-d/go-git.v4/plumbing/transport/ssh"
+	"io"
 	"io/ioutil"
 )
 
@@ -56,12 +57,17 @@ func Build(workingDirectory, buildScript string) (string, string, error) {
 	return stdout, stderr, nil
 }
 
-func Trace(workingDirectory, apiTraceLocation, executableToTrace string, timeout int) (string, string, error) {
+func Trace(workingDirectory, apiTraceLocation, executableToTrace, api, traceMode string, timeout int) (string, string, error) {
+
+	if traceMode == Wrapper {
+		return traceWithWrapper(workingDirectory, apiTraceLocation, executableToTrace, timeout)
+	}
 
 	args := []string{
 		fmt.Sprintf("%ds", timeout),
 		apiTraceLocation,
 		"trace",
+		fmt.Sprintf("--api=%s", TraceAPI(api)),
 		fmt.Sprintf("./%s", executableToTrace),
 	}
 
@@ -74,22 +80,18 @@ func Trace(workingDirectory, apiTraceLocation, executableToTrace string, timeout
 	return stdout, stderr, nil
 }
 
-func Dump(workingDirectory, apitraceLocation, traceLocation string) (string, string, error) {
+// Dump the trace, streaming apitrace's stdout into consume while it runs, and return apitrace's stderr
+func Dump(workingDirectory, apitraceLocation, traceLocation string, consume func(io.Reader) error) (string, error) {
 
 	args := []string{
 		"dump",
 		"-v",
 		"--color=never",
+		"--thread-ids",
 		traceLocation,
 	}
 
-	stdout, stderr, err := execute(workingDirectory, apitraceLocation, args)
-
-	if err != nil {
-		return stdout, stderr, err
-	}
-
-	return stdout, stderr, nil
+	return executeStreaming(workingDirectory, apitraceLocation, args, consume)
 }
 
 func DumpImages(workingDirectory, apitraceLocation, traceLocation, callID string) (string, string, error) {
@@ -110,6 +112,11 @@ func DumpImages(workingDirectory, apitraceLocation, traceLocation, callID string
 	return stdout, stderr, nil
 }
 
+// Run `apitrace leaks`, which replays the calls that create and delete objects and reports those left undeleted
+func Leaks(workingDirectory, apitraceLocation, traceLocation string) (string, string, error) {
+	return execute(workingDirectory, apitraceLocation, []string{"leaks", traceLocation})
+}
+
 func Retrace(workingDirectory, glretraceLocation, traceLocation, callID string) (string, string, error) {
 
 	args := []string{
@@ -118,16 +125,13 @@ func Retrace(workingDirectory, glretraceLocation, traceLocation, callID string)
 		fmt.Sprintf("--D=%s", callID),
 		"--dump-format=json",
 		traceLocation,
-		"|",
-		"tr",
-		"-d",
-		"'\n'",
 	}
 
-	stdout, _, err := execute(workingDirectory, glretraceLocation, args)
+	// glretrace reports GL errors, debug output and mismatches on stderr, against the calls of this run
+	stdout, stderr, err := execute(workingDirectory, glretraceLocation, args)
 
 	if err != nil {
-		return stdout, "", err
+		return stdout, stderr, err
 	}
 
 	args = []string{
@@ -138,10 +142,11 @@ func Retrace(workingDirectory, glretraceLocation, traceLocation, callID string)
 		traceLocation,
 	}
 
-	_, stderr, err := execute(workingDirectory, glretraceLocation, args)
+	// the same calls are replayed again, so this run's stderr only matters when it fails
+	_, ubjsonStderr, err := execute(workingDirectory, glretraceLocation, args)
 
 	if err != nil {
-		return stdout, stderr, err
+		return stdout, ubjsonStderr, err
 	}
 
 	return stdout, stderr, nil
@@ -0,0 +1,33 @@
+package operations
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Compile and link a shader on its own with glslangValidator, which works out the stage from the file extension.
+// Returns what the validator wrote; its exit status only says whether there were errors, which the output lists
+func ValidateShader(validator, extension, source string) (string, error) {
+	dir, err := ioutil.TempDir("", "shader")
+
+	if err != nil {
+		return "", err
+	}
+
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "shader."+extension)
+
+	if err := ioutil.WriteFile(file, []byte(source), 0644); err != nil {
+		return "", err
+	}
+
+	stdout, stderr, err := execute(dir, validator, []string{"-l", file})
+
+	if err != nil {
+		return "", err
+	}
+
+	return stdout + stderr, nil
+}
@@ -0,0 +1,64 @@
+package operations
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type Capabilities struct {
+	DumpImages bool `json:"dumpImages"`
+	PGPU       bool `json:"pgpu"`
+	UBJSON     bool `json:"ubjson"`
+	EGLRetrace bool `json:"eglRetrace"`
+	Leaks      bool `json:"leaks"`
+}
+
+// Check that a binary exists at the given location and is executable
+func ValidateBinary(location string) error {
+
+	if len(location) == 0 {
+		return fmt.Errorf("no binary location given")
+	}
+
+	info, err := os.Stat(location)
+
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", location)
+	}
+
+	if info.Mode()&0111 == 0 {
+		return fmt.Errorf("%s is not executable", location)
+	}
+
+	return nil
+}
+
+// Work out which optional features an apitrace and glretrace pair supports, based on their help output, and whether
+// there is an eglretrace to replay EGL and GLES traces with, see RetraceLocation
+func ProbeCapabilities(workingDirectory, apiTraceLocation, glretraceLocation, eglretraceLocation string) Capabilities {
+
+	var capabilities Capabilities
+
+	apiTraceHelp, apiTraceHelpErr, err := execute(workingDirectory, apiTraceLocation, []string{"help"})
+
+	if err == nil {
+		capabilities.DumpImages = strings.Contains(apiTraceHelp+apiTraceHelpErr, "dump-images")
+		capabilities.Leaks = strings.Contains(apiTraceHelp+apiTraceHelpErr, "leaks")
+	}
+
+	retraceHelp, retraceHelpErr, err := execute(workingDirectory, glretraceLocation, []string{"--help"})
+
+	if err == nil {
+		capabilities.PGPU = strings.Contains(retraceHelp+retraceHelpErr, "--pgpu")
+		capabilities.UBJSON = strings.Contains(retraceHelp+retraceHelpErr, "ubjson")
+	}
+
+	capabilities.EGLRetrace = ValidateBinary(RetraceLocation(glretraceLocation, eglretraceLocation, EGL)) == nil
+
+	return capabilities
+}
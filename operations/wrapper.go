@@ -0,0 +1,129 @@
+package operations
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// apitrace's GLX wrapper, and the names of libGL it stands in for in wrapper mode
+const glxWrapper = "glxtrace.so"
+
+var libGLNames = []string{"libGL.so.1", "libGL.so"}
+
+// how ldconfig describes the libraries the server's architecture loads, e.g. libGL.so.1 (libc6,x86-64)
+var ldconfigArchitectures = map[string]string{
+	"amd64": "x86-64",
+	"arm64": "AArch64",
+}
+
+// Where apitrace keeps its GLX wrapper: next to it in a build tree, or in lib/apitrace/wrappers of the prefix it is
+// installed in, with or without a multiarch directory such as lib/x86_64-linux-gnu
+func WrapperLocation(apiTraceLocation string) (string, error) {
+	bin := filepath.Dir(apiTraceLocation)
+
+	candidates := []string{
+		filepath.Join(bin, "wrappers", glxWrapper),
+		filepath.Join(bin, "..", "lib", "apitrace", "wrappers", glxWrapper),
+	}
+
+	multiarch, _ := filepath.Glob(filepath.Join(bin, "..", "lib", "*", "apitrace", "wrappers", glxWrapper))
+
+	for _, candidate := range append(candidates, multiarch...) {
+		if _, err := os.Stat(candidate); err == nil {
+			return filepath.Clean(candidate), nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find apitrace's %s in %s or %s", glxWrapper, candidates[0], candidates[1])
+}
+
+// Run the executable with apitrace's wrapper standing in for libGL, for apps that load GL in ways preloading can't
+// intercept. The wrapper is linked under libGL's names in a directory put first on LD_LIBRARY_PATH, and TRACE_LIBGL
+// tells it where the real libGL is, as loading libGL by name would now load the wrapper again
+func traceWithWrapper(workingDirectory, apiTraceLocation, executableToTrace string, timeout int) (string, string, error) {
+	wrapper, err := WrapperLocation(apiTraceLocation)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	libGL, err := systemLibrary(workingDirectory, libGLNames[0])
+
+	if err != nil {
+		return "", "", err
+	}
+
+	dir, err := ioutil.TempDir("", "apitrace-wrapper")
+
+	if err != nil {
+		return "", "", err
+	}
+
+	defer os.RemoveAll(dir)
+
+	for _, name := range libGLNames {
+		if err := os.Symlink(wrapper, filepath.Join(dir, name)); err != nil {
+			return "", "", err
+		}
+	}
+
+	libraryPath := dir
+
+	if existing := os.Getenv("LD_LIBRARY_PATH"); len(existing) > 0 {
+		libraryPath += ":" + existing
+	}
+
+	args := []string{
+		fmt.Sprintf("%ds", timeout),
+		fmt.Sprintf("./%s", executableToTrace),
+	}
+
+	environment := []string{
+		"LD_LIBRARY_PATH=" + libraryPath,
+		"TRACE_LIBGL=" + libGL,
+	}
+
+	return executeWithEnvironment(workingDirectory, "timeout", args, environment)
+}
+
+// Find where the dynamic linker loads a library from, from `ldconfig -p`
+func systemLibrary(workingDirectory, name string) (string, error) {
+	stdout, _, err := execute(workingDirectory, "ldconfig", []string{"-p"})
+
+	if err != nil {
+		stdout, _, err = execute(workingDirectory, "/sbin/ldconfig", []string{"-p"})
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("could not list the system libraries to find %s: %s", name, err.Error())
+	}
+
+	if path, ok := parseLDConfig(stdout, name, ldconfigArchitectures[runtime.GOARCH]); ok {
+		return path, nil
+	}
+
+	return "", fmt.Errorf("could not find %s among the system libraries", name)
+}
+
+// Pick a library out of ldconfig's listing, whose lines look like
+// "	libGL.so.1 (libc6,x86-64) => /usr/lib/x86_64-linux-gnu/libGL.so.1". When the architecture is known only a library
+// built for it will do, otherwise the first listed is taken
+func parseLDConfig(listing, name, architecture string) (string, bool) {
+	for _, line := range strings.Split(listing, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), " => ", 2)
+
+		if len(fields) != 2 || !strings.HasPrefix(fields[0], name+" ") {
+			continue
+		}
+
+		if len(architecture) == 0 || strings.Contains(fields[0], architecture) {
+			return fields[1], true
+		}
+	}
+
+	return "", false
+}
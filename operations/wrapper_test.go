@@ -0,0 +1,108 @@
+package operations
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseLDConfig(t *testing.T) {
+	const listing = `1234 libs found in cache ` + "`/etc/ld.so.cache'" + `
+	libGLX.so.0 (libc6,x86-64) => /usr/lib/x86_64-linux-gnu/libGLX.so.0
+	libGL.so.1 (libc6) => /usr/lib/i386-linux-gnu/libGL.so.1
+	libGL.so.1 (libc6,x86-64) => /usr/lib/x86_64-linux-gnu/libGL.so.1
+	libGL.so (libc6,x86-64) => /usr/lib/x86_64-linux-gnu/libGL.so
+	libEGL.so.1 (libc6,AArch64) => /usr/lib/aarch64-linux-gnu/libEGL.so.1`
+
+	tests := []struct {
+		name         string
+		library      string
+		architecture string
+		path         string
+	}{
+		{"the server's architecture", "libGL.so.1", "x86-64", "/usr/lib/x86_64-linux-gnu/libGL.so.1"},
+		{"an unknown architecture", "libGL.so.1", "", "/usr/lib/i386-linux-gnu/libGL.so.1"},
+		{"names sharing a prefix", "libGL.so", "x86-64", "/usr/lib/x86_64-linux-gnu/libGL.so"},
+		{"only other architectures", "libEGL.so.1", "x86-64", ""},
+		{"missing", "libGLESv2.so.2", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, ok := parseLDConfig(listing, test.library, test.architecture)
+
+			if path != test.path || ok != (len(test.path) > 0) {
+				t.Errorf("expected <%s>, got <%s>", test.path, path)
+			}
+		})
+	}
+}
+
+func TestWrapperLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		wrapper string
+	}{
+		{"build tree", "build/wrappers/glxtrace.so"},
+		{"installed", "usr/lib/apitrace/wrappers/glxtrace.so"},
+		{"installed with multiarch", "usr/lib/x86_64-linux-gnu/apitrace/wrappers/glxtrace.so"},
+		{"missing", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			apitrace := filepath.Join(dir, "usr", "bin", "apitrace")
+
+			if test.name == "build tree" {
+				apitrace = filepath.Join(dir, "build", "apitrace")
+			}
+
+			want := ""
+
+			if len(test.wrapper) > 0 {
+				want = filepath.Join(dir, test.wrapper)
+
+				if err := os.MkdirAll(filepath.Dir(want), 0755); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := ioutil.WriteFile(want, nil, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			location, err := WrapperLocation(apitrace)
+
+			if location != want || (err == nil) != (len(want) > 0) {
+				t.Errorf("expected <%s>, got <%s> and error %v", want, location, err)
+			}
+		})
+	}
+}
+
+func TestValidateTraceMode(t *testing.T) {
+	tests := []struct {
+		mode  string
+		api   string
+		valid bool
+	}{
+		{"", "", true},
+		{Preload, GLES2, true},
+		{Wrapper, "", true},
+		{Wrapper, GL, true},
+		{Wrapper, EGL, false},
+		{Wrapper, GLES1, false},
+		{"inject", GL, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode+" "+test.api, func(t *testing.T) {
+			if err := ValidateTraceMode(test.mode, test.api); (err == nil) != test.valid {
+				t.Errorf("expected valid to be %t, got the error %v", test.valid, err)
+			}
+		})
+	}
+}
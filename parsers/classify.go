@@ -0,0 +1,54 @@
+package parsers
+
+import (
+	"strings"
+)
+
+// Whether a call draws or dispatches compute work. Immediate mode drawing is counted once, at glBegin
+func IsDrawCall(function string) bool {
+	// glDrawBuffer and glDrawBuffers pick the colour attachments to draw to, and draw nothing
+	if strings.HasPrefix(function, "glDrawBuffer") {
+		return false
+	}
+
+	switch {
+	case strings.HasPrefix(function, "glDraw"),
+		strings.HasPrefix(function, "glMultiDraw"),
+		strings.HasPrefix(function, "glDispatchCompute"),
+		strings.HasPrefix(function, "vkCmdDraw"),
+		strings.HasPrefix(function, "vkCmdDispatch"):
+		return true
+	}
+
+	switch function {
+	case "glBegin", "glCallList", "glCallLists":
+		return true
+	}
+
+	return false
+}
+
+// The prefixes of calls that change pipeline state: capabilities, bindings, blending, depth and stencil, rasterisation,
+// uniforms, vertex attributes and sampler parameters
+var stateChangePrefixes = []string{
+	"glEnable", "glDisable", "glBind", "glUseProgram", "glActiveTexture", "glBlend", "glDepth", "glStencil",
+	"glViewport", "glScissor", "glCullFace", "glFrontFace", "glPolygonMode", "glPolygonOffset", "glColorMask",
+	"glLineWidth", "glPointSize", "glLogicOp", "glSampleCoverage", "glSampleMask", "glMinSampleShading",
+	"glPatchParameter", "glProvokingVertex", "glPrimitiveRestartIndex", "glClipControl", "glUniform",
+	"glProgramUniform", "glVertexAttrib", "glEnableVertexAttribArray", "glDisableVertexAttribArray",
+	"glTexParameter", "glSamplerParameter", "glPixelStore", "glClearColor", "glClearDepth", "glClearStencil",
+	"glDrawBuffer", "glReadBuffer", "glHint", "glMatrixMode", "glLoadIdentity", "glLoadMatrix", "glMultMatrix",
+	"glPushMatrix", "glPopMatrix", "glTranslate", "glRotate", "glScale", "glOrtho", "glFrustum",
+	"vkCmdBind", "vkCmdSet", "vkCmdPushConstants",
+}
+
+// Whether a call changes the state later draws are made with
+func IsStateChange(function string) bool {
+	for _, prefix := range stateChangePrefixes {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package parsers
+
+import (
+	"strings"
+)
+
+// The position of the context argument in each call that makes a context current
+var makeCurrentContexts = map[string]int{
+	"glXMakeCurrent":        2,
+	"glXMakeContextCurrent": 3,
+	"eglMakeCurrent":        3,
+	"wglMakeCurrent":        1,
+	"CGLSetCurrentContext":  0,
+}
+
+// Follows which GL context is current on each thread, so every call can be tagged with the context it was made on
+type ContextTracker struct {
+	current map[int]string
+}
+
+func NewContextTracker() *ContextTracker {
+	return &ContextTracker{current: map[int]string{}}
+}
+
+// Tag a call with the context current on its thread. Calls that make a context current are tagged with the new
+// context, unless they failed
+func (t *ContextTracker) Tag(call *Call) {
+	if index, ok := makeCurrentContexts[call.FunctionName]; ok && index < len(call.ParamValues) && !failed(call) {
+		context := strings.TrimSpace(call.ParamValues[index])
+
+		// releasing the current context leaves the thread without one
+		if context == "NULL" || context == "0" || context == "EGL_NO_CONTEXT" {
+			context = ""
+		}
+
+		t.current[call.Thread] = context
+	}
+
+	call.Context = t.current[call.Thread]
+}
+
+// glX, WGL and EGL return False, FALSE or EGL_FALSE when they fail, while CGL returns an error code that is only
+// kCGLNoError, or zero, on success
+func failed(call *Call) bool {
+	ret := call.Return
+
+	if ret == nil {
+		return false
+	}
+
+	if strings.HasPrefix(call.FunctionName, "CGL") {
+		return ret.Symbol != "kCGLNoError" && (ret.Int == nil || *ret.Int != 0)
+	}
+
+	if b, ok := ret.Boolean(); ok {
+		return !b
+	}
+
+	return ret.Int != nil && *ret.Int == 0
+}
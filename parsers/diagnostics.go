@@ -0,0 +1,150 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Something glretrace reported about a call while replaying it: a GL error, a debug output message or a mismatch
+// between the trace and the replay. Call and Frame are the call it was reported against and the frame holding it, when
+// they are known. Error is the GL error enum the report names, if any
+type Diagnostic struct {
+	Call     *int   `json:"call,omitempty"`
+	Frame    *int   `json:"frame,omitempty"`
+	Severity string `json:"severity"`
+	Function string `json:"function,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Message  string `json:"message"`
+}
+
+// The errors glGetError returns
+var glErrors = map[string]bool{
+	"GL_INVALID_ENUM":                  true,
+	"GL_INVALID_VALUE":                 true,
+	"GL_INVALID_OPERATION":             true,
+	"GL_STACK_OVERFLOW":                true,
+	"GL_STACK_UNDERFLOW":               true,
+	"GL_OUT_OF_MEMORY":                 true,
+	"GL_INVALID_FRAMEBUFFER_OPERATION": true,
+	"GL_CONTEXT_LOST":                  true,
+	"GL_TABLE_TOO_LARGE":               true,
+}
+
+var (
+	// glretrace prefixes what it reports about a call with the call number, e.g. "123: warning: ...", and the thread
+	// the call was made on when the trace has several, e.g. "123 @1: warning: ..."
+	callPrefixPattern = regexp.MustCompile(`^(\d+)(?: @\d+)?: (.*)$`)
+
+	// the function reported against may come before the tag, e.g. "123: glDrawArrays: warning: ...", and debug output
+	// comes from glDebugOutputCallback with no tag at all
+	functionPrefixPattern = regexp.MustCompile(`^((?:gl|egl|wgl|CGL)\w*): (.*)$`)
+
+	tagPattern = regexp.MustCompile(`^(warning|error|message|info|debug): (.*)$`)
+
+	// glretrace checks glGetError after each call, e.g. "glGetError(glDrawArrays) = GL_INVALID_OPERATION"
+	getErrorPattern = regexp.MustCompile(`glGetError\((\w+)\)`)
+
+	// debug output messages carry the severity the driver gave them, e.g. "High severity api error 1282, ..."
+	debugSeverityPattern = regexp.MustCompile(`(?i)\b(high|medium|low|notification) severity\b`)
+
+	glEnumPattern = regexp.MustCompile(`\bGL_[A-Z0-9_]+\b`)
+)
+
+// the function glretrace reports debug output messages from
+const debugOutputCallback = "glDebugOutputCallback"
+
+// Read the diagnostics out of glretrace's stderr, one per line, leaving out its progress messages
+func ParseDiagnostics(stderr string) []*Diagnostic {
+	diagnostics := []*Diagnostic{}
+
+	for _, line := range strings.Split(stderr, "\n") {
+		if diagnostic := parseDiagnostic(strings.TrimSpace(line)); diagnostic != nil {
+			diagnostics = append(diagnostics, diagnostic)
+		}
+	}
+
+	return diagnostics
+}
+
+// A line is a diagnostic when it is tagged, or is debug output; anything else is progress or output of the replay
+func parseDiagnostic(line string) *Diagnostic {
+	diagnostic := &Diagnostic{}
+
+	if match := callPrefixPattern.FindStringSubmatch(line); match != nil {
+		if call, err := strconv.Atoi(match[1]); err == nil {
+			diagnostic.Call = &call
+		}
+
+		line = match[2]
+	}
+
+	if match := functionPrefixPattern.FindStringSubmatch(line); match != nil {
+		diagnostic.Function = match[1]
+		line = match[2]
+	}
+
+	tag := "message"
+
+	if match := tagPattern.FindStringSubmatch(line); match != nil {
+		tag = match[1]
+		line = match[2]
+	} else if diagnostic.Function != debugOutputCallback {
+		return nil
+	}
+
+	if diagnostic.Function == debugOutputCallback {
+		diagnostic.Function = ""
+	}
+
+	diagnostic.Message = line
+
+	if function := getErrorPattern.FindStringSubmatch(line); function != nil {
+		diagnostic.Function = function[1]
+	}
+
+	for _, enum := range glEnumPattern.FindAllString(line, -1) {
+		if glErrors[enum] {
+			diagnostic.Error = enum
+			break
+		}
+	}
+
+	diagnostic.Severity = severity(tag, line, diagnostic.Error)
+
+	return diagnostic
+}
+
+// GL errors are always errors, debug output keeps the severity the driver gave it, and everything else the tag
+// glretrace gave it
+func severity(tag, message, glError string) string {
+	if len(glError) > 0 {
+		return SeverityError
+	}
+
+	if debug := debugSeverityPattern.FindStringSubmatch(message); debug != nil {
+		switch strings.ToLower(debug[1]) {
+		case "high":
+			return SeverityError
+		case "notification":
+			return SeverityInfo
+		default:
+			return SeverityWarning
+		}
+	}
+
+	switch tag {
+	case "error":
+		return SeverityError
+	case "warning":
+		return SeverityWarning
+	}
+
+	return SeverityInfo
+}
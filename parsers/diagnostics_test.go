@@ -0,0 +1,128 @@
+package parsers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDiagnostics(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+
+		// each diagnostic as its call, severity, function and error, and message
+		diagnostics []string
+	}{
+		{
+			name:   "GL errors",
+			stderr: `1234: warning: glGetError(glTexImage2D) = GL_INVALID_ENUM`,
+			diagnostics: []string{
+				"1234 error glTexImage2D GL_INVALID_ENUM: glGetError(glTexImage2D) = GL_INVALID_ENUM",
+			},
+		},
+		{
+			// every error glGetError returns after a call is reported on its own line
+			name: "several GL errors after one call",
+			stderr: `87: warning: glGetError(glDrawElements) = GL_INVALID_OPERATION
+87: warning: glGetError(glDrawElements) = GL_OUT_OF_MEMORY`,
+			diagnostics: []string{
+				"87 error glDrawElements GL_INVALID_OPERATION: glGetError(glDrawElements) = GL_INVALID_OPERATION",
+				"87 error glDrawElements GL_OUT_OF_MEMORY: glGetError(glDrawElements) = GL_OUT_OF_MEMORY",
+			},
+		},
+		{
+			name:   "GL errors with the function before the tag",
+			stderr: `1234: glDrawArrays: warning: glGetError() = GL_INVALID_OPERATION`,
+			diagnostics: []string{
+				"1234 error glDrawArrays GL_INVALID_OPERATION: glGetError() = GL_INVALID_OPERATION",
+			},
+		},
+		{
+			name:   "GL errors of calls on other threads",
+			stderr: `56 @2: warning: glGetError(glBindTexture) = GL_INVALID_VALUE`,
+			diagnostics: []string{
+				"56 error glBindTexture GL_INVALID_VALUE: glGetError(glBindTexture) = GL_INVALID_VALUE",
+			},
+		},
+		{
+			// debug output keeps the severity the driver gave it, unless it names a GL error
+			name: "debug output",
+			stderr: `310: glDebugOutputCallback: High severity api error 1282, GL_INVALID_OPERATION in glUniform1i(program not linked)
+311: glDebugOutputCallback: Medium severity api performance issue 131218, Program/shader state performance warning: Vertex shader in program 3 is being recompiled based on GL state.
+312: glDebugOutputCallback: Low severity shader compiler unknown issue 1, Shader Stats: SSA: 12 inst
+313: glDebugOutputCallback: notification severity api unknown issue 131185, Buffer detailed info: Buffer object 1 will use VIDEO memory as the source for buffer object operations.`,
+			diagnostics: []string{
+				"310 error - GL_INVALID_OPERATION: High severity api error 1282, GL_INVALID_OPERATION in glUniform1i(program not linked)",
+				"311 warning - -: Medium severity api performance issue 131218, Program/shader state performance warning: " +
+					"Vertex shader in program 3 is being recompiled based on GL state.",
+				"312 warning - -: Low severity shader compiler unknown issue 1, Shader Stats: SSA: 12 inst",
+				"313 info - -: notification severity api unknown issue 131185, Buffer detailed info: Buffer object 1 will use " +
+					"VIDEO memory as the source for buffer object operations.",
+			},
+		},
+		{
+			name: "mismatches and unsupported calls",
+			stderr: `12: warning: unsupported glXCreateContextAttribsARB call
+45: warning: glXMakeCurrent failed
+1020: error: failed to map buffer 7
+warning: unknown function glFooBarEXT`,
+			diagnostics: []string{
+				"12 warning - -: unsupported glXCreateContextAttribsARB call",
+				"45 warning - -: glXMakeCurrent failed",
+				"1020 error - -: failed to map buffer 7",
+				"- warning - -: unknown function glFooBarEXT",
+			},
+		},
+		{
+			// progress, timings and output of the replay aren't diagnostics
+			name: "progress",
+			stderr: `
+Rendered 120 frames in 2.04417 secs, average of 58.7036 fps
+Mesa: User error: GL_INVALID_ENUM in glTexParameteri(pname)
+123: glDrawArrays(mode = GL_TRIANGLES, first = 0, count = 3)
+  `,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diagnostics := []string{}
+
+			for _, diagnostic := range ParseDiagnostics(test.stderr) {
+				diagnostics = append(diagnostics, describeDiagnostic(diagnostic))
+			}
+
+			want := test.diagnostics
+
+			if want == nil {
+				want = []string{}
+			}
+
+			if !reflect.DeepEqual(diagnostics, want) {
+				t.Errorf("expected the diagnostics\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(diagnostics, "\n"))
+			}
+		})
+	}
+}
+
+func describeDiagnostic(d *Diagnostic) string {
+	call := "-"
+
+	if d.Call != nil {
+		call = fmt.Sprint(*d.Call)
+	}
+
+	parts := []string{call, d.Severity}
+
+	for _, part := range []string{d.Function, d.Error} {
+		if len(part) == 0 {
+			part = "-"
+		}
+
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, " ") + ": " + d.Message
+}
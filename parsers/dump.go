@@ -3,7 +3,9 @@ package parsers
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"log"
+	"strconv"
 	"strings"
 )
 
@@ -12,8 +14,13 @@ type TraceDump struct {
 }
 
 type Frame struct {
-	ID    int     `json:"id"`
-	Calls []*Call `json:"calls"`
+	ID        int     `json:"id"`
+	FirstCall int     `json:"firstCall"`
+	LastCall  int     `json:"lastCall"`
+	Calls     []*Call `json:"calls"`
+
+	// the trace ended before the frame was presented, usually because the tracer was killed partway through it
+	Incomplete bool `json:"incomplete"`
 }
 
 type Call struct {
@@ -22,6 +29,17 @@ type Call struct {
 	ParamNames   []string `json:"paramNames"`
 	ParamValues  []string `json:"paramValues"`
 	ReturnValue  string   `json:"returnValue"`
+
+	// The same arguments and return value with their types worked out, see Value
+	Args   []*Argument `json:"args"`
+	Return *Value      `json:"return,omitempty"`
+
+	// The thread the call was made on, and the GL context current on that thread when it was made, if any
+	Thread  int    `json:"thread"`
+	Context string `json:"context,omitempty"`
+
+	// What glretrace reported about the call the last time a retrace replayed it
+	Diagnostics []*Diagnostic `json:"diagnostics,omitempty"`
 }
 
 type ImageSet struct {
@@ -32,275 +50,103 @@ type ImageSet struct {
 	CallID  string   `json:"callID"`
 }
 
+// Called with each frame as soon as its last call has been parsed; returning an error stops the parse
+type FrameHandler func(frame *Frame) error
+
+// Parse a whole dump at once. Dumps are streamed with StreamDump when traces are ingested; this is for dumps small
+// enough to hold in memory, such as those of the tests
 func ParseDump(dumpContents string) *TraceDump {
 
 	td := new(TraceDump)
 
-	frameNumber := 0
-
-	scanner := bufio.NewScanner(strings.NewReader(dumpContents))
-
-	frame := new(Frame)
-	frame.ID = frameNumber
-
-	shaderSource := false
-
-	shaderSourceIndex := -1
-
-	var str strings.Builder
-
-	var c *Call
-
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		if !shaderSource {
-			// this is probably the beginning of a shader source declaration
-			if strings.Contains(line, "#") && strings.Contains(line, "version") {
-
-				c = new(Call)
-				// example line for this scenario
-				// 193 glShaderSource(shader = 1, count = 1, string = &"#version 330
-
-				shaderSource = true
-
-				// 193
-				callID := strings.Fields(line)[0]
-
-				lineComponents := strings.Split(line, "(")
-
-				// 193 glShaderSource
-				callIDAndFunctionName := lineComponents[0]
-
-				// shader = 1, count = 1, string = &"#version 330
-				someParametersAndReturn := lineComponents[1]
-
-				someParameters := strings.Split(someParametersAndReturn, ")")
-
-				returnValue := ""
-
-				if len(someParameters) > 1 {
-					returnValue = someParameters[1]
-				}
-				// glShaderSource
-				functionName := strings.Split(callIDAndFunctionName, " ")[1]
-
-				parameterPairs := strings.Split(someParameters[0], " = ")
-
-				// "attribs", {kCGLPFAAccelerated, kCGLPFAClosestPolicy, kCGLPFAOpenGLProfile, kCGLOGLPVersion_3_2_Core, kCGLPFAColorSize, 24, kCGLPFAAlphaSize, 8, kCGLPFADepthSize, 24, kCGLPFAStencilSize, 8, kCGLPFADoubleBuffer, kCGLPFASampleBuffers, 0, 0}, pix
-
-				// first param name
-				c.ParamNames = append(c.ParamNames, strings.TrimSpace(parameterPairs[0]))
-
-				var i int
-
-				for i = 1; i < len(parameterPairs); i++ {
-
-					p := parameterPairs[i]
-
-					nv := strings.Split(p, ", ")
-
-					//n := nv[0]
-					//
-					//v := nv[1]
-
-					if len(nv) > 1 {
-						previousValue := strings.Join(nv[0:len(nv)-1], " ")
-						nextName := nv[len(nv)-1]
-
-						c.ParamNames = append(c.ParamNames, strings.TrimSpace(nextName))
-						c.ParamValues = append(c.ParamValues, previousValue)
-					}
-
-				}
-
-				// last value
-				c.ParamValues = append(c.ParamValues, parameterPairs[len(parameterPairs)-1])
-
-				if strings.Contains(parameterPairs[len(parameterPairs)-1], "#") && strings.Contains(parameterPairs[len(parameterPairs)-1], "version") {
-					shaderSourceIndex = i
-				}
-
-				c.ID = callID
-				c.FunctionName = functionName
-				c.ReturnValue = returnValue
-
-			} else {
-				// regular line, nothing fancy
-
-				line = strings.TrimSpace(line)
-				//fmt.Println(line)
-
-				if strings.HasPrefix(line, "//") {
-					log.Println("Comment, skipping")
-					log.Println(line)
-					continue
-				}
-
-				// if it's an empty line, new frame!
-				if len(line) == 0 {
-					// add the current frame to the trace dump array of frames
-					td.Frames = append(td.Frames, frame)
-
-					frame = new(Frame)
-					frameNumber++
+	StreamDump(strings.NewReader(dumpContents), func(frame *Frame) error {
+		td.Frames = append(td.Frames, frame)
+		return nil
+	})
 
-					frame.ID = frameNumber
-
-				} else {
-					c = new(Call)
-
-					// 209
-					callID := strings.Fields(line)[0]
-
-					lineComponents := strings.Split(line, "(")
-
-					//fmt.Println(lineComponents)
-
-					if len(lineComponents) < 2 {
-						log.Println("Skipping this line (, possibly malformed")
-						log.Println(line)
-						continue
-					}
-
-					// 209 CGLFlushDrawable
-					callIDAndFunctionName := lineComponents[0]
-
-					// ctx = 0x805a200) = kCGLNoError
-					someParametersAndReturn := lineComponents[1]
-
-					someParameters := strings.Split(someParametersAndReturn, ")")
-
-					if len(someParameters) < 2 {
-						log.Println("Skipping this line ), possibly malformed")
-						log.Println(line)
-						continue
-					}
-
-					//  = kCGLNoError
-					returnValue := someParameters[1]
-
-					// CGLFlushDrawable
-					functionName := strings.Split(callIDAndFunctionName, " ")[1]
-
-					// attribs = {kCGLPFAAccelerated, kCGLPFAClosestPolicy, kCGLPFAOpenGLProfile, kCGLOGLPVersion_3_2_Core, kCGLPFAColorSize, 24, kCGLPFAAlphaSize, 8, kCGLPFADepthSize, 24, kCGLPFAStencilSize, 8, kCGLPFADoubleBuffer, kCGLPFASampleBuffers, 0, 0}, pix = &0x4700020, npix = &2
-					parameterPairs := strings.Split(someParameters[0], " = ")
-
-					// "attribs", {kCGLPFAAccelerated, kCGLPFAClosestPolicy, kCGLPFAOpenGLProfile, kCGLOGLPVersion_3_2_Core, kCGLPFAColorSize, 24, kCGLPFAAlphaSize, 8, kCGLPFADepthSize, 24, kCGLPFAStencilSize, 8, kCGLPFADoubleBuffer, kCGLPFASampleBuffers, 0, 0}, pix
-
-					// first param name
-					c.ParamNames = append(c.ParamNames, strings.TrimSpace(parameterPairs[0]))
-
-					var i int
-
-					for i = 1; i < len(parameterPairs); i++ {
+	return td
+}
 
-						p := parameterPairs[i]
+// Parse `apitrace dump` output as it is read, handing each frame to handle as soon as its end-of-frame call has been
+// parsed, so only the frame being parsed is ever held in memory. Calls after the last end-of-frame call are handed on
+// as a final, incomplete frame. Returns the number of frames handled
+func StreamDump(r io.Reader, handle FrameHandler) (int, error) {
 
-						nv := strings.Split(p, ", ")
+	frameNumber := 0
 
-						//n := nv[0]
-						//
-						//v := nv[1]
+	frame := new(Frame)
+	frame.ID = frameNumber
 
-						if len(nv) > 1 {
-							previousValue := strings.Join(nv[0:len(nv)-1], " ")
-							nextName := nv[len(nv)-1]
+	parser := NewDumpParser(r)
 
-							c.ParamNames = append(c.ParamNames, strings.TrimSpace(nextName))
-							c.ParamValues = append(c.ParamValues, previousValue)
-						}
+	contexts := NewContextTracker()
 
-					}
+	for {
+		node, err := parser.Next()
 
-					// last value
-					c.ParamValues = append(c.ParamValues, parameterPairs[len(parameterPairs)-1])
+		if err == io.EOF {
+			break
+		}
 
-					if strings.Contains(parameterPairs[len(parameterPairs)-1], "#") && strings.Contains(parameterPairs[len(parameterPairs)-1], "version") {
-						shaderSourceIndex = i
-					}
+		if err != nil {
+			log.Println("Skipping this line, possibly malformed")
+			log.Println(err.Error())
+			continue
+		}
 
-					c.ID = callID
-					c.FunctionName = functionName
-					c.ReturnValue = returnValue
+		call := NewCall(node)
+		contexts.Tag(call)
 
-					for i := 0; i < len(c.ParamNames); i++ {
-						if len(c.ParamNames[i]) == 0 {
-							c.ParamNames = append(c.ParamNames[:i], c.ParamNames[i+1:]...)
-							c.ParamValues = append(c.ParamValues[:i], c.ParamValues[i+1:]...)
-						}
-					}
+		frame.AddCall(call)
 
-					frame.Calls = append(frame.Calls, c)
-				}
+		if EndsFrame(node.Function) {
+			if err := handle(frame); err != nil {
+				return frameNumber, err
 			}
-		} else {
-			// inside a shader source
 
-			//fmt.Println(line)
+			frame = new(Frame)
+			frameNumber++
 
-			if strings.Contains(line, "\", length =") && strings.Contains(line, ")") {
-
-				// }", length = NULL, something = TEST)
-				removedParens := strings.Split(line, ")")
-
-				// }", length = NULL
-				remainingParameters := strings.Split(removedParens[0], ",")
-				// "}", length", "NULL"
-
-				str.WriteString(remainingParameters[0])
-
-				outstandingParameters := remainingParameters[1]
-
-				//fmt.Println(len(c.ParamValues))
-				//fmt.Println(c.ParamValues)
-				//fmt.Println(shaderSourceIndex)
-				c.ParamValues[shaderSourceIndex-2] = fmt.Sprintf("%s\\n%s", c.ParamValues[shaderSourceIndex-2], str.String())
-
-				parameterPairs := strings.Split(outstandingParameters, " = ")
-
-				// first param name
-				c.ParamNames = append(c.ParamNames, strings.TrimSpace(parameterPairs[0]))
-
-				var i int
-
-				for i = 1; i < len(parameterPairs); i++ {
-
-					p := parameterPairs[i]
-
-					nv := strings.Split(p, ", ")
-
-					if len(nv) > 1 {
-						previousValue := strings.Join(nv[0:len(nv)-1], " ")
-						nextName := nv[len(nv)-1]
-
-						c.ParamNames = append(c.ParamNames, strings.TrimSpace(nextName))
-						c.ParamValues = append(c.ParamValues, previousValue)
-					}
+			frame.ID = frameNumber
+		}
+	}
 
-				}
+	if len(frame.Calls) > 0 {
+		frame.Incomplete = true
 
-				// last value
-				c.ParamValues = append(c.ParamValues, parameterPairs[len(parameterPairs)-1])
+		if err := handle(frame); err != nil {
+			return frameNumber, err
+		}
 
-				shaderSource = false
+		frameNumber++
+	}
 
-				for i := 0; i < len(c.ParamNames); i++ {
-					if len(c.ParamNames[i]) == 0 {
-						c.ParamNames = append(c.ParamNames[:i], c.ParamNames[i+1:]...)
-						c.ParamValues = append(c.ParamValues[:i], c.ParamValues[i+1:]...)
-					}
-				}
+	return frameNumber, nil
+}
 
-				frame.Calls = append(frame.Calls, c)
-			} else {
-				str.WriteString(fmt.Sprintf("%s\\n", line))
-			}
-		}
+// Flatten a parsed call into the names and values of its arguments, both rendered and typed
+func NewCall(node *CallNode) *Call {
+	c := new(Call)
+
+	c.ID = strconv.Itoa(node.Number)
+	c.Thread = node.Thread
+	c.FunctionName = node.Function
+	c.ParamNames = []string{}
+	c.ParamValues = []string{}
+	c.Args = []*Argument{}
+
+	for _, arg := range node.Args {
+		c.ParamNames = append(c.ParamNames, arg.Name)
+		c.ParamValues = append(c.ParamValues, arg.Value.String())
+		c.Args = append(c.Args, &Argument{arg.Name, NewValue(arg.Value)})
+	}
 
+	if node.Return != nil {
+		c.ReturnValue = node.Return.String()
+		c.Return = NewValue(node.Return)
 	}
 
-	return td
+	return c
 }
 
 func ParseImageDumpFile(imageDump string) *ImageSet {
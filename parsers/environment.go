@@ -0,0 +1,45 @@
+package parsers
+
+import (
+	"strings"
+)
+
+type GLStrings struct {
+	Vendor   string `json:"vendor"`
+	Renderer string `json:"renderer"`
+	Version  string `json:"version"`
+}
+
+// Pick up the string returned by a glGetString call, keeping the first one seen for each name, so the strings can be
+// found while a dump is streamed. Returns whether all three strings have been found
+func (gs *GLStrings) Record(call *Call) bool {
+
+	if call.FunctionName == "glGetString" && len(call.ParamValues) > 0 {
+		value := returnedString(call.ReturnValue)
+
+		switch strings.TrimSpace(call.ParamValues[0]) {
+		case "GL_VENDOR":
+			if len(gs.Vendor) == 0 {
+				gs.Vendor = value
+			}
+		case "GL_RENDERER":
+			if len(gs.Renderer) == 0 {
+				gs.Renderer = value
+			}
+		case "GL_VERSION":
+			if len(gs.Version) == 0 {
+				gs.Version = value
+			}
+		}
+	}
+
+	return len(gs.Vendor) > 0 && len(gs.Renderer) > 0 && len(gs.Version) > 0
+}
+
+// turn ` = "Mesa DRI Intel(R) HD Graphics 620"` into `Mesa DRI Intel(R) HD Graphics 620`
+func returnedString(returnValue string) string {
+	value := strings.TrimSpace(returnValue)
+	value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+
+	return strings.Trim(value, `"`)
+}
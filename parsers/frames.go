@@ -0,0 +1,39 @@
+package parsers
+
+import (
+	"strconv"
+)
+
+// The calls that end a frame: presenting the back buffer, or the GREMEDY frame terminator that single buffered
+// applications can call
+var frameTerminators = map[string]bool{
+	"glXSwapBuffers":              true,
+	"eglSwapBuffers":              true,
+	"eglSwapBuffersWithDamageEXT": true,
+	"eglSwapBuffersWithDamageKHR": true,
+	"wglSwapBuffers":              true,
+	"wglSwapLayerBuffers":         true,
+	"CGLFlushDrawable":            true,
+	"glFrameTerminatorGREMEDY":    true,
+	"vkQueuePresentKHR":           true,
+}
+
+// Whether a call to function ends a frame
+func EndsFrame(function string) bool {
+	return frameTerminators[function]
+}
+
+// Add a call to the end of the frame, widening the range of call numbers the frame spans
+func (frame *Frame) AddCall(call *Call) {
+	if number, err := strconv.Atoi(call.ID); err == nil {
+		if len(frame.Calls) == 0 || number < frame.FirstCall {
+			frame.FirstCall = number
+		}
+
+		if len(frame.Calls) == 0 || number > frame.LastCall {
+			frame.LastCall = number
+		}
+	}
+
+	frame.Calls = append(frame.Calls, call)
+}
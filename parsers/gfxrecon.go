@@ -0,0 +1,159 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+)
+
+// one line of gfxrecon-convert's JSON lines output; header and metadata lines have no function
+type gfxreconRecord struct {
+	Index    int               `json:"index"`
+	VkFunc   *gfxreconFunction `json:"vkFunc"`
+	Function *gfxreconFunction `json:"function"`
+}
+
+type gfxreconFunction struct {
+	Name   string          `json:"name"`
+	Thread int             `json:"thread"`
+	Return json.RawMessage `json:"return"`
+	Args   json.RawMessage `json:"args"`
+}
+
+// Parse gfxrecon-convert output line by line, handing each frame to handle as soon as it is presented. The calls after
+// the last present are handed on as a final, incomplete frame. Returns the number of frames handled
+func StreamGFXReconJSON(r io.Reader, handle FrameHandler) (int, error) {
+
+	frames := 0
+
+	frame := new(Frame)
+
+	reader := bufio.NewReader(r)
+
+	for {
+		line, readErr := reader.ReadBytes('\n')
+
+		line = bytes.TrimSpace(line)
+
+		if len(line) > 0 {
+			var record gfxreconRecord
+
+			if err := json.Unmarshal(line, &record); err != nil {
+				log.Println("Skipping this line, not valid JSON")
+				log.Println(err.Error())
+			} else if function := record.function(); function != nil {
+				c, err := gfxreconCall(record.Index, function)
+
+				if err != nil {
+					return frames, err
+				}
+
+				frame.AddCall(c)
+
+				if EndsFrame(function.Name) {
+					if err := handle(frame); err != nil {
+						return frames, err
+					}
+
+					frames++
+
+					frame = new(Frame)
+					frame.ID = frames
+				}
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			return frames, readErr
+		}
+	}
+
+	if len(frame.Calls) > 0 {
+		frame.Incomplete = true
+
+		if err := handle(frame); err != nil {
+			return frames, err
+		}
+
+		frames++
+	}
+
+	return frames, nil
+}
+
+func (record *gfxreconRecord) function() *gfxreconFunction {
+	if record.VkFunc != nil {
+		return record.VkFunc
+	}
+
+	return record.Function
+}
+
+func gfxreconCall(index int, function *gfxreconFunction) (*Call, error) {
+	c := new(Call)
+
+	c.ID = strconv.Itoa(index)
+	c.Thread = function.Thread
+	c.FunctionName = function.Name
+	c.ParamNames = []string{}
+	c.ParamValues = []string{}
+
+	if len(function.Return) > 0 {
+		c.ReturnValue = gfxreconValue(function.Return)
+	}
+
+	if len(function.Args) == 0 {
+		return c, nil
+	}
+
+	// decode the arguments token by token, since unmarshalling into a map would lose their order
+	decoder := json.NewDecoder(bytes.NewReader(function.Args))
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("could not read the arguments of call %d: %s", index, err.Error())
+	}
+
+	for decoder.More() {
+		name, err := decoder.Token()
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read the arguments of call %d: %s", index, err.Error())
+		}
+
+		var value json.RawMessage
+
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("could not read the arguments of call %d: %s", index, err.Error())
+		}
+
+		c.ParamNames = append(c.ParamNames, fmt.Sprintf("%v", name))
+		c.ParamValues = append(c.ParamValues, gfxreconValue(value))
+	}
+
+	return c, nil
+}
+
+// strings are unquoted, everything else (handles, structs, arrays) is kept as compact JSON
+func gfxreconValue(raw json.RawMessage) string {
+	var str string
+
+	if len(raw) > 0 && raw[0] == '"' && json.Unmarshal(raw, &str) == nil {
+		return str
+	}
+
+	var compacted bytes.Buffer
+
+	if err := json.Compact(&compacted, raw); err != nil {
+		return string(raw)
+	}
+
+	return compacted.String()
+}
@@ -0,0 +1,572 @@
+package parsers
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The syntax tree for `apitrace dump` output:
+//
+//	dump     = { call | comment | newline }
+//	call     = number [ "@" number ] identifier "(" [ argument { "," argument } ] ")" [ "=" value ] [ comment ] newline
+//	argument = [ identifier "=" ] value
+//	value    = operand { "|" operand }
+//	operand  = number | identifier | string | wstring | "?" | "&" operand | "blob" "(" number ")"
+//	         | "{" [ element { "," element } ] "}"
+//	element  = [ identifier "=" ] value
+//
+// Single element arrays are written as references (&value), and braces hold either an array or, when every element
+// is named, a struct. The thread a call was made on is only written by `apitrace dump --thread-ids`
+type CallNode struct {
+	Number   int
+	Thread   int
+	Function string
+	Args     []*ArgNode
+	Return   Node
+	Comment  string
+	Line     int
+}
+
+type ArgNode struct {
+	Name  string
+	Value Node
+}
+
+type Node interface {
+	// Render the node the way apitrace writes it
+	String() string
+}
+
+type LiteralKind int
+
+const (
+	NumberLiteral LiteralKind = iota
+	IdentifierLiteral
+	StringLiteral
+	WideStringLiteral
+	UnknownLiteral
+)
+
+// For string literals the text is unescaped
+type LiteralNode struct {
+	Kind LiteralKind
+	Text string
+}
+
+type BitmaskNode struct {
+	Flags []Node
+}
+
+type ReferenceNode struct {
+	Value Node
+}
+
+type ArrayNode struct {
+	Elements []Node
+}
+
+type StructNode struct {
+	Members []*ArgNode
+}
+
+type BlobNode struct {
+	Size int64
+}
+
+func (n *LiteralNode) String() string {
+	switch n.Kind {
+	case StringLiteral:
+		return quote(n.Text)
+	case WideStringLiteral:
+		return "L" + quote(n.Text)
+	default:
+		return n.Text
+	}
+}
+
+func (n *BitmaskNode) String() string {
+	flags := make([]string, len(n.Flags))
+
+	for i, flag := range n.Flags {
+		flags[i] = flag.String()
+	}
+
+	return strings.Join(flags, " | ")
+}
+
+func (n *ReferenceNode) String() string {
+	return "&" + n.Value.String()
+}
+
+func (n *ArrayNode) String() string {
+	elements := make([]string, len(n.Elements))
+
+	for i, element := range n.Elements {
+		elements[i] = element.String()
+	}
+
+	return "{" + strings.Join(elements, ", ") + "}"
+}
+
+func (n *StructNode) String() string {
+	members := make([]string, len(n.Members))
+
+	for i, member := range n.Members {
+		members[i] = fmt.Sprintf("%s = %s", member.Name, member.Value.String())
+	}
+
+	return "{" + strings.Join(members, ", ") + "}"
+}
+
+func (n *BlobNode) String() string {
+	return fmt.Sprintf("blob(%d)", n.Size)
+}
+
+func quote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+
+	return `"` + s + `"`
+}
+
+type SyntaxError struct {
+	Line    int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Reads calls one at a time from `apitrace dump` output
+type DumpParser struct {
+	lexer  *lexer
+	tok    token
+	peeked bool
+
+	// whether the last thing consumed was the end of a line, so recovering from an error has nothing to skip
+	lineStart bool
+}
+
+func NewDumpParser(r io.Reader) *DumpParser {
+	return &DumpParser{
+		lexer:     newLexer(r),
+		lineStart: true,
+	}
+}
+
+// Return the next call in the dump, or io.EOF at the end of it. A *SyntaxError means the offending line was skipped,
+// and parsing can carry on with the next call
+func (p *DumpParser) Next() (*CallNode, error) {
+	for {
+		t, err := p.peek()
+
+		if err != nil {
+			p.skipLine()
+			return nil, err
+		}
+
+		switch {
+		case t.kind == tokenEOF:
+			return nil, io.EOF
+
+		case t.kind == tokenNewline || t.kind == tokenComment:
+			p.advance()
+
+		case t.kind == tokenNumber:
+			call, err := p.call()
+
+			if err != nil {
+				p.skipLine()
+				return nil, err
+			}
+
+			return call, nil
+
+		default:
+			p.advance()
+			p.skipLine()
+			return nil, &SyntaxError{t.line, fmt.Sprintf("expected a call number, found %s", t)}
+		}
+	}
+}
+
+func (p *DumpParser) peek() (token, error) {
+	if !p.peeked {
+		t, err := p.lexer.next()
+
+		// the lexer's errors are already a *SyntaxError, with the line the bad token started on
+		if err != nil {
+			p.lineStart = false
+			return token{}, err
+		}
+
+		p.tok = t
+		p.peeked = true
+	}
+
+	return p.tok, nil
+}
+
+func (p *DumpParser) advance() (token, error) {
+	t, err := p.peek()
+
+	if err == nil {
+		p.peeked = false
+		p.lineStart = t.kind == tokenNewline
+	}
+
+	return t, err
+}
+
+// Consume everything up to and including the next newline, ignoring anything the lexer cannot make sense of
+func (p *DumpParser) skipLine() {
+	if p.lineStart {
+		return
+	}
+
+	for {
+		t, err := p.advance()
+
+		if err != nil {
+			continue
+		}
+
+		if t.kind == tokenNewline || t.kind == tokenEOF {
+			return
+		}
+	}
+}
+
+func (p *DumpParser) expect(kind tokenKind, text string) (token, error) {
+	t, err := p.advance()
+
+	if err != nil {
+		return t, err
+	}
+
+	if t.kind != kind || (len(text) > 0 && t.text != text) {
+		expected := kind.String()
+
+		if len(text) > 0 {
+			expected = fmt.Sprintf("'%s'", text)
+		}
+
+		return t, &SyntaxError{t.line, fmt.Sprintf("expected %s, found %s", expected, t)}
+	}
+
+	return t, nil
+}
+
+func (p *DumpParser) isPunctuation(text string) bool {
+	t, err := p.peek()
+
+	return err == nil && t.kind == tokenPunctuation && t.text == text
+}
+
+func (p *DumpParser) call() (*CallNode, error) {
+	number, err := p.expect(tokenNumber, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	call := new(CallNode)
+	call.Line = number.line
+
+	call.Number, err = strconv.Atoi(number.text)
+
+	if err != nil {
+		return nil, &SyntaxError{number.line, fmt.Sprintf("invalid call number <%s>", number.text)}
+	}
+
+	if p.isPunctuation("@") {
+		p.advance()
+
+		thread, err := p.expect(tokenNumber, "")
+
+		if err != nil {
+			return nil, err
+		}
+
+		call.Thread, err = strconv.Atoi(thread.text)
+
+		if err != nil {
+			return nil, &SyntaxError{thread.line, fmt.Sprintf("invalid thread number <%s>", thread.text)}
+		}
+	}
+
+	function, err := p.expect(tokenIdentifier, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	call.Function = function.text
+
+	if _, err := p.expect(tokenPunctuation, "("); err != nil {
+		return nil, err
+	}
+
+	if !p.isPunctuation(")") {
+		for {
+			arg, err := p.element()
+
+			if err != nil {
+				return nil, err
+			}
+
+			call.Args = append(call.Args, arg)
+
+			if !p.isPunctuation(",") {
+				break
+			}
+
+			p.advance()
+		}
+	}
+
+	if _, err := p.expect(tokenPunctuation, ")"); err != nil {
+		return nil, err
+	}
+
+	if p.isPunctuation("=") {
+		p.advance()
+
+		call.Return, err = p.value()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t, err := p.peek()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t.kind == tokenComment {
+		call.Comment = t.text
+		p.advance()
+	}
+
+	t, err = p.advance()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t.kind == tokenEOF {
+		return call, nil
+	}
+
+	if t.kind != tokenNewline {
+		return nil, &SyntaxError{t.line, fmt.Sprintf("expected the end of the call, found %s", t)}
+	}
+
+	return call, nil
+}
+
+// an argument or a brace element, which may be named
+func (p *DumpParser) element() (*ArgNode, error) {
+	t, err := p.peek()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t.kind != tokenIdentifier {
+		value, err := p.value()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &ArgNode{Value: value}, nil
+	}
+
+	// an identifier is either a name followed by "=", or the start of the value itself
+	p.advance()
+
+	if p.isPunctuation("=") {
+		p.advance()
+
+		value, err := p.value()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &ArgNode{Name: t.text, Value: value}, nil
+	}
+
+	value, err := p.valueAfter(t)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArgNode{Value: value}, nil
+}
+
+func (p *DumpParser) value() (Node, error) {
+	t, err := p.advance()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return p.valueAfter(t)
+}
+
+// parse a value whose first token has already been consumed
+func (p *DumpParser) valueAfter(first token) (Node, error) {
+	operand, err := p.operand(first)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isPunctuation("|") {
+		return operand, nil
+	}
+
+	bitmask := &BitmaskNode{Flags: []Node{operand}}
+
+	for p.isPunctuation("|") {
+		p.advance()
+
+		t, err := p.advance()
+
+		if err != nil {
+			return nil, err
+		}
+
+		flag, err := p.operand(t)
+
+		if err != nil {
+			return nil, err
+		}
+
+		bitmask.Flags = append(bitmask.Flags, flag)
+	}
+
+	return bitmask, nil
+}
+
+func (p *DumpParser) operand(t token) (Node, error) {
+	switch t.kind {
+	case tokenNumber:
+		return &LiteralNode{NumberLiteral, t.text}, nil
+
+	case tokenString:
+		return &LiteralNode{StringLiteral, t.text}, nil
+
+	case tokenWideString:
+		return &LiteralNode{WideStringLiteral, t.text}, nil
+
+	case tokenIdentifier:
+		if t.text == "blob" && p.isPunctuation("(") {
+			return p.blob()
+		}
+
+		return &LiteralNode{IdentifierLiteral, t.text}, nil
+
+	case tokenPunctuation:
+		switch t.text {
+		case "?":
+			return &LiteralNode{UnknownLiteral, t.text}, nil
+
+		case "&":
+			next, err := p.advance()
+
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := p.operand(next)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return &ReferenceNode{value}, nil
+
+		case "{":
+			return p.braces()
+		}
+	}
+
+	return nil, &SyntaxError{t.line, fmt.Sprintf("expected a value, found %s", t)}
+}
+
+func (p *DumpParser) blob() (Node, error) {
+	p.advance()
+
+	size, err := p.expect(tokenNumber, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.expect(tokenPunctuation, ")"); err != nil {
+		return nil, err
+	}
+
+	n, err := strconv.ParseInt(size.text, 0, 64)
+
+	if err != nil {
+		return nil, &SyntaxError{size.line, fmt.Sprintf("invalid blob size <%s>", size.text)}
+	}
+
+	return &BlobNode{n}, nil
+}
+
+func (p *DumpParser) braces() (Node, error) {
+	var elements []*ArgNode
+
+	if !p.isPunctuation("}") {
+		for {
+			element, err := p.element()
+
+			if err != nil {
+				return nil, err
+			}
+
+			elements = append(elements, element)
+
+			if !p.isPunctuation(",") {
+				break
+			}
+
+			p.advance()
+		}
+	}
+
+	if _, err := p.expect(tokenPunctuation, "}"); err != nil {
+		return nil, err
+	}
+
+	named := len(elements) > 0
+
+	for _, element := range elements {
+		if len(element.Name) == 0 {
+			named = false
+		}
+	}
+
+	if named {
+		return &StructNode{elements}, nil
+	}
+
+	array := &ArrayNode{Elements: []Node{}}
+
+	for _, element := range elements {
+		array.Elements = append(array.Elements, element.Value)
+	}
+
+	return array, nil
+}
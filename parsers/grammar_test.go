@@ -0,0 +1,185 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// Each testdata/<name>.txt is parsed call by call, and the calls and errors compared with testdata/<name>.json
+func TestDumpParserGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		what string
+	}{
+		{"strings", "strings holding commas, parentheses, quotes and escapes, and wide strings"},
+		{"structs", "structs, arrays, references to single values and structs, and unknown values"},
+		{"bitmasks", "bitmasks with a numeric remainder, and blobs of decimal and hexadecimal size"},
+		{"shaders", "shader sources spanning several lines, and escaped onto one"},
+		{"threads", "calls tagged with the thread that made them by @thread"},
+		{"floats", "infinities, NaNs, exponents and negative zero"},
+		{"recovery", "skipping malformed lines and carrying on with the next call"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input, err := ioutil.ReadFile(filepath.Join("testdata", test.name+".txt"))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := json.MarshalIndent(parseAll(t, input), "", "  ")
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", test.name+".json")
+
+			if *update {
+				if err := ioutil.WriteFile(golden, append(got, '\n'), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := ioutil.ReadFile(golden)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(bytes.TrimSpace(got), bytes.TrimSpace(want)) {
+				t.Errorf("parsing %s gave\n%s\nwant\n%s", test.what, got, want)
+			}
+		})
+	}
+}
+
+// A parsed call or the error parsing it, in the order the parser returned them
+type result struct {
+	Call  *goldenCall `json:"call,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+type goldenCall struct {
+	Number   int         `json:"number"`
+	Thread   int         `json:"thread,omitempty"`
+	Function string      `json:"function"`
+	Args     []goldenArg `json:"args"`
+	Return   goldenValue `json:"return,omitempty"`
+	Comment  string      `json:"comment,omitempty"`
+	Line     int         `json:"line"`
+}
+
+type goldenArg struct {
+	Name  string      `json:"name,omitempty"`
+	Value goldenValue `json:"value"`
+}
+
+// a node, keyed by its kind, so the golden files say which kind of node each value was parsed into
+type goldenValue map[string]interface{}
+
+func parseAll(t *testing.T, input []byte) []result {
+	parser := NewDumpParser(bytes.NewReader(input))
+
+	results := []result{}
+
+	for {
+		call, err := parser.Next()
+
+		if err == io.EOF {
+			return results
+		}
+
+		if err != nil {
+			if _, ok := err.(*SyntaxError); !ok {
+				t.Fatalf("expected a *SyntaxError, got %T: %s", err, err)
+			}
+
+			results = append(results, result{Error: err.Error()})
+			continue
+		}
+
+		results = append(results, result{Call: golden(call)})
+
+		if len(results) > 100 {
+			t.Fatal("the parser did not reach the end of the dump")
+		}
+	}
+}
+
+func golden(call *CallNode) *goldenCall {
+	g := &goldenCall{
+		Number:   call.Number,
+		Thread:   call.Thread,
+		Function: call.Function,
+		Args:     goldenArgs(call.Args),
+		Comment:  call.Comment,
+		Line:     call.Line,
+	}
+
+	if call.Return != nil {
+		g.Return = goldenNode(call.Return)
+	}
+
+	return g
+}
+
+func goldenArgs(args []*ArgNode) []goldenArg {
+	golden := []goldenArg{}
+
+	for _, arg := range args {
+		golden = append(golden, goldenArg{Name: arg.Name, Value: goldenNode(arg.Value)})
+	}
+
+	return golden
+}
+
+func goldenNodes(nodes []Node) []goldenValue {
+	golden := []goldenValue{}
+
+	for _, node := range nodes {
+		golden = append(golden, goldenNode(node))
+	}
+
+	return golden
+}
+
+func goldenNode(node Node) goldenValue {
+	switch n := node.(type) {
+	case *LiteralNode:
+		kinds := map[LiteralKind]string{
+			NumberLiteral:     "number",
+			IdentifierLiteral: "identifier",
+			StringLiteral:     "string",
+			WideStringLiteral: "wstring",
+			UnknownLiteral:    "unknown",
+		}
+
+		return goldenValue{kinds[n.Kind]: n.Text}
+
+	case *BitmaskNode:
+		return goldenValue{"bitmask": goldenNodes(n.Flags)}
+
+	case *ReferenceNode:
+		return goldenValue{"reference": goldenNode(n.Value)}
+
+	case *ArrayNode:
+		return goldenValue{"array": goldenNodes(n.Elements)}
+
+	case *StructNode:
+		return goldenValue{"struct": goldenArgs(n.Members)}
+
+	case *BlobNode:
+		return goldenValue{"blob": n.Size}
+	}
+
+	return goldenValue{"unexpected": node.String()}
+}
@@ -0,0 +1,52 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A leak reported by `apitrace leaks`. Call is the call the report names, usually the one that created the object,
+// and Frame the frame holding it, when they are known
+type ReportedLeak struct {
+	Call    *int   `json:"call,omitempty"`
+	Frame   *int   `json:"frame,omitempty"`
+	Message string `json:"message"`
+}
+
+// apitrace names calls either by a leading call number, as in its dumps, or as "call 123"
+var (
+	leadingCallPattern = regexp.MustCompile(`^(\d+)\b`)
+	namedCallPattern   = regexp.MustCompile(`\bcall\s+#?(\d+)`)
+)
+
+// Read the leaks out of the output of `apitrace leaks`, one per line, leaving out apitrace's own progress messages
+func ParseLeaks(output string) []*ReportedLeak {
+	leaks := []*ReportedLeak{}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if len(line) == 0 || strings.HasPrefix(line, "apitrace:") {
+			continue
+		}
+
+		leak := &ReportedLeak{Message: line}
+
+		match := leadingCallPattern.FindStringSubmatch(line)
+
+		if match == nil {
+			match = namedCallPattern.FindStringSubmatch(line)
+		}
+
+		if match != nil {
+			if call, err := strconv.Atoi(match[1]); err == nil {
+				leak.Call = &call
+			}
+		}
+
+		leaks = append(leaks, leak)
+	}
+
+	return leaks
+}
@@ -0,0 +1,301 @@
+package parsers
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNewline
+	tokenNumber
+	tokenIdentifier
+	tokenString
+	tokenWideString
+	tokenComment
+	tokenPunctuation
+)
+
+var tokenKindNames = map[tokenKind]string{
+	tokenEOF:         "end of dump",
+	tokenNewline:     "newline",
+	tokenNumber:      "number",
+	tokenIdentifier:  "identifier",
+	tokenString:      "string",
+	tokenWideString:  "wide string",
+	tokenComment:     "comment",
+	tokenPunctuation: "punctuation",
+}
+
+func (kind tokenKind) String() string {
+	return tokenKindNames[kind]
+}
+
+// For strings, text holds the unescaped contents without the quotes
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF || t.kind == tokenNewline {
+		return t.kind.String()
+	}
+
+	return fmt.Sprintf("%s <%s>", t.kind, t.text)
+}
+
+// Splits `apitrace dump` output into tokens. Strings may span several lines, so the lexer works on the whole stream
+// rather than line by line
+type lexer struct {
+	reader *bufio.Reader
+	line   int
+}
+
+func newLexer(r io.Reader) *lexer {
+	return &lexer{
+		reader: bufio.NewReaderSize(r, 64*1024),
+		line:   1,
+	}
+}
+
+func (l *lexer) read() (rune, bool) {
+	r, _, err := l.reader.ReadRune()
+
+	if err != nil {
+		return 0, false
+	}
+
+	return r, true
+}
+
+func (l *lexer) unread() {
+	l.reader.UnreadRune()
+}
+
+func (l *lexer) peek() (rune, bool) {
+	r, ok := l.read()
+
+	if ok {
+		l.unread()
+	}
+
+	return r, ok
+}
+
+func (l *lexer) next() (token, error) {
+	for {
+		r, ok := l.read()
+
+		if !ok {
+			return token{kind: tokenEOF, line: l.line}, nil
+		}
+
+		switch {
+		case r == '\n':
+			t := token{kind: tokenNewline, text: "\n", line: l.line}
+			l.line++
+			return t, nil
+
+		case r == ' ' || r == '\t' || r == '\r':
+			continue
+
+		case r == '/':
+			if next, ok := l.peek(); ok && next == '/' {
+				return l.comment(), nil
+			}
+
+			return token{}, l.errorf("unexpected '/'")
+
+		case r == '"':
+			return l.string(tokenString)
+
+		case r == 'L':
+			if next, ok := l.peek(); ok && next == '"' {
+				l.read()
+				return l.string(tokenWideString)
+			}
+
+			return l.identifier(r), nil
+
+		case isDigit(r):
+			return l.number(r), nil
+
+		case r == '-':
+			next, ok := l.peek()
+
+			if ok && (isDigit(next) || isLetter(next)) {
+				return l.number(r), nil
+			}
+
+			return token{}, l.errorf("unexpected '-'")
+
+		case isLetter(r):
+			return l.identifier(r), nil
+
+		case strings.ContainsRune("(){},=&|@?", r):
+			return token{kind: tokenPunctuation, text: string(r), line: l.line}, nil
+
+		default:
+			return token{}, l.errorf("unexpected character %q", r)
+		}
+	}
+}
+
+// a comment runs to the end of the line, the newline itself is left for the parser
+func (l *lexer) comment() token {
+	var b strings.Builder
+
+	line := l.line
+
+	for {
+		r, ok := l.read()
+
+		if !ok {
+			break
+		}
+
+		if r == '\n' {
+			l.unread()
+			break
+		}
+
+		b.WriteRune(r)
+	}
+
+	return token{kind: tokenComment, text: strings.TrimSpace(strings.TrimPrefix(b.String(), "/")), line: line}
+}
+
+// apitrace escapes quotes and backslashes and writes non-printable bytes as octal escapes. Newlines and tabs are left as
+// they are, unless the dump was written on one line per call, where they are escaped as \n and \t
+func (l *lexer) string(kind tokenKind) (token, error) {
+	var b strings.Builder
+
+	line := l.line
+
+	for {
+		r, ok := l.read()
+
+		if !ok {
+			return token{}, &SyntaxError{line, "unterminated string"}
+		}
+
+		switch r {
+		case '"':
+			return token{kind: kind, text: b.String(), line: line}, nil
+
+		case '\n':
+			l.line++
+			b.WriteRune(r)
+
+		case '\\':
+			escaped, ok := l.read()
+
+			if !ok {
+				return token{}, &SyntaxError{line, "unterminated string"}
+			}
+
+			if escaped >= '0' && escaped <= '7' {
+				value := int(escaped - '0')
+
+				for i := 0; i < 2; i++ {
+					digit, ok := l.peek()
+
+					if !ok || digit < '0' || digit > '7' {
+						break
+					}
+
+					l.read()
+					value = value*8 + int(digit-'0')
+				}
+
+				b.WriteByte(byte(value))
+			} else if control, ok := controlEscapes[escaped]; ok {
+				b.WriteRune(control)
+			} else {
+				b.WriteRune(escaped)
+			}
+
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
+
+var controlEscapes = map[rune]rune{'n': '\n', 't': '\t', 'r': '\r'}
+
+// numbers cover integers, hexadecimal pointers and bitmask remainders, and floats including inf and nan
+func (l *lexer) number(first rune) token {
+	var b strings.Builder
+
+	b.WriteRune(first)
+
+	previous := first
+
+	for {
+		r, ok := l.read()
+
+		if !ok {
+			break
+		}
+
+		exponentSign := (r == '-' || r == '+') && (previous == 'e' || previous == 'E') && !strings.HasPrefix(b.String(), "0x")
+
+		if !isDigit(r) && !isLetter(r) && r != '.' && !exponentSign {
+			l.unread()
+			break
+		}
+
+		b.WriteRune(r)
+		previous = r
+	}
+
+	return token{kind: tokenNumber, text: b.String(), line: l.line}
+}
+
+func (l *lexer) identifier(first rune) token {
+	var b strings.Builder
+
+	b.WriteRune(first)
+
+	for {
+		r, ok := l.read()
+
+		if !ok {
+			break
+		}
+
+		if !isDigit(r) && !isLetter(r) {
+			l.unread()
+			break
+		}
+
+		b.WriteRune(r)
+	}
+
+	text := b.String()
+
+	// apitrace writes infinities and NaNs the way the C++ stream does
+	if text == "inf" || text == "nan" {
+		return token{kind: tokenNumber, text: text, line: l.line}
+	}
+
+	return token{kind: tokenIdentifier, text: text, line: l.line}
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{l.line, fmt.Sprintf(format, args...)}
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isLetter(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
@@ -0,0 +1,53 @@
+package parsers
+
+import (
+	"strings"
+)
+
+// The kind of GL object named by arguments of these names, whether they hold one name or an array of them
+var objectKinds = map[string]string{
+	"texture":       "texture",
+	"textures":      "texture",
+	"buffer":        "buffer",
+	"buffers":       "buffer",
+	"program":       "program",
+	"shader":        "shader",
+	"shaders":       "shader",
+	"framebuffer":   "framebuffer",
+	"framebuffers":  "framebuffer",
+	"renderbuffer":  "renderbuffer",
+	"renderbuffers": "renderbuffer",
+	"sampler":       "sampler",
+	"samplers":      "sampler",
+	"vaobj":         "vertexarray",
+	"pipeline":      "pipeline",
+	"pipelines":     "pipeline",
+	"id":            "query",
+	"ids":           "query",
+}
+
+// The kind of GL object an argument of a function names, if it names one. Vertex arrays are passed as "array", which
+// also names plain arrays elsewhere, and "id" only names a query object in the query functions
+func ObjectKind(function, arg string) (string, bool) {
+	switch {
+	case (arg == "array" || arg == "arrays") && strings.Contains(function, "VertexArray"):
+		return "vertexarray", true
+	case (arg == "id" || arg == "ids") && !strings.Contains(function, "Query") && !strings.Contains(function, "Queries"):
+		return "", false
+	}
+
+	kind, ok := objectKinds[arg]
+
+	return kind, ok
+}
+
+// Whether kind is one of the kinds of object ObjectKind finds
+func IsObjectKind(kind string) bool {
+	for _, k := range objectKinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
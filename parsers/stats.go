@@ -0,0 +1,277 @@
+package parsers
+
+import (
+	"sort"
+	"strings"
+)
+
+// Counts of the kinds of work a frame or trace does. Uploads only count calls that hand over data: glBufferData and
+// glTexImage2D calls given NULL just allocate storage
+type Stats struct {
+	Calls              int   `json:"calls"`
+	Draws              int   `json:"draws"`
+	StateChanges       int   `json:"stateChanges"`
+	BufferUploads      int   `json:"bufferUploads"`
+	BufferUploadBytes  int64 `json:"bufferUploadBytes"`
+	TextureUploads     int   `json:"textureUploads"`
+	TextureUploadBytes int64 `json:"textureUploadBytes"`
+	ProgramBinds       int   `json:"programBinds"`
+	FramebufferBinds   int   `json:"framebufferBinds"`
+}
+
+type FrameStats struct {
+	Frame int `json:"frame"`
+	Stats
+
+	// the number of calls to each function, left out of the trace's timeline
+	Functions    map[string]int   `json:"functions,omitempty"`
+	TopFunctions []*FunctionCount `json:"topFunctions,omitempty"`
+}
+
+type TraceStats struct {
+	Frames int `json:"frames"`
+	Stats
+
+	Functions    map[string]int   `json:"functions,omitempty"`
+	TopFunctions []*FunctionCount `json:"topFunctions,omitempty"`
+
+	// every frame's counts in order, for charting without reading the frames themselves
+	Timeline []*FrameStats `json:"timeline"`
+}
+
+type FunctionCount struct {
+	Function string `json:"function"`
+	Calls    int    `json:"calls"`
+}
+
+// Works out the statistics of a trace a frame at a time, as it is dumped
+type StatsBuilder struct {
+	trace *TraceStats
+}
+
+func NewStatsBuilder() *StatsBuilder {
+	return &StatsBuilder{trace: &TraceStats{Functions: map[string]int{}, Timeline: []*FrameStats{}}}
+}
+
+// Count the calls of a frame, adding them to the trace's totals
+func (b *StatsBuilder) Add(frame *Frame) *FrameStats {
+	stats := &FrameStats{Frame: frame.ID, Functions: map[string]int{}}
+
+	for _, call := range frame.Calls {
+		stats.add(call)
+		b.trace.Functions[call.FunctionName]++
+	}
+
+	b.trace.Frames++
+	b.trace.Stats.merge(&stats.Stats)
+	b.trace.Timeline = append(b.trace.Timeline, &FrameStats{Frame: frame.ID, Stats: stats.Stats})
+
+	return stats
+}
+
+// The statistics of every frame added so far
+func (b *StatsBuilder) Stats() *TraceStats {
+	return b.trace
+}
+
+func (stats *FrameStats) add(call *Call) {
+	stats.Calls++
+	stats.Functions[call.FunctionName]++
+
+	if IsDrawCall(call.FunctionName) {
+		stats.Draws++
+	}
+
+	if IsStateChange(call.FunctionName) {
+		stats.StateChanges++
+	}
+
+	if bytes, ok := bufferUpload(call); ok {
+		stats.BufferUploads++
+		stats.BufferUploadBytes += bytes
+	}
+
+	if bytes, ok := TextureUpload(call); ok {
+		stats.TextureUploads++
+		stats.TextureUploadBytes += bytes
+	}
+
+	switch Vendorless(call.FunctionName) {
+	case "glUseProgram", "glUseProgramObject", "glBindProgramPipeline", "glBindProgram":
+		stats.ProgramBinds++
+	case "glBindFramebuffer":
+		stats.FramebufferBinds++
+	}
+}
+
+func (stats *Stats) merge(other *Stats) {
+	stats.Calls += other.Calls
+	stats.Draws += other.Draws
+	stats.StateChanges += other.StateChanges
+	stats.BufferUploads += other.BufferUploads
+	stats.BufferUploadBytes += other.BufferUploadBytes
+	stats.TextureUploads += other.TextureUploads
+	stats.TextureUploadBytes += other.TextureUploadBytes
+	stats.ProgramBinds += other.ProgramBinds
+	stats.FramebufferBinds += other.FramebufferBinds
+}
+
+// The n most called functions, most called first; every function when n is 0
+func TopFunctions(functions map[string]int, n int) []*FunctionCount {
+	counts := make([]*FunctionCount, 0, len(functions))
+
+	for function, calls := range functions {
+		counts = append(counts, &FunctionCount{function, calls})
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Calls != counts[j].Calls {
+			return counts[i].Calls > counts[j].Calls
+		}
+
+		return counts[i].Function < counts[j].Function
+	})
+
+	if n > 0 && n < len(counts) {
+		counts = counts[:n]
+	}
+
+	return counts
+}
+
+var vendorSuffixes = []string{"ARB", "EXT", "OES", "NV", "AMD", "APPLE", "INTEL", "KHR"}
+
+// A function's name without the suffix of the extension it came from, e.g. glBindFramebuffer for glBindFramebufferEXT
+func Vendorless(function string) string {
+	for _, suffix := range vendorSuffixes {
+		if strings.HasSuffix(function, suffix) {
+			return strings.TrimSuffix(function, suffix)
+		}
+	}
+
+	return function
+}
+
+// The number of bytes a call hands to a buffer. memcpy calls are how apitrace records writes to mapped buffers
+func bufferUpload(call *Call) (int64, bool) {
+	var size, data *Value
+
+	switch Vendorless(call.FunctionName) {
+	case "glBufferData", "glNamedBufferData", "glBufferStorage", "glNamedBufferStorage",
+		"glBufferSubData", "glNamedBufferSubData":
+		size, data = call.Arg("size"), call.Arg("data")
+	case "memcpy":
+		size, data = call.Arg("n"), call.Arg("src")
+	default:
+		return 0, false
+	}
+
+	if data.IsNull() {
+		return 0, false
+	}
+
+	bytes, _ := size.Integer()
+
+	return bytes, true
+}
+
+// The number of bytes a call hands to a texture: the size of the image when it was captured as a blob, the image size
+// given to compressed uploads, and otherwise worked out from the dimensions, format and type
+func TextureUpload(call *Call) (int64, bool) {
+	function := Vendorless(call.FunctionName)
+
+	compressed := strings.HasPrefix(function, "glCompressedTex")
+
+	switch {
+	case strings.HasPrefix(function, "glTexImage"), strings.HasPrefix(function, "glTexSubImage"),
+		strings.HasPrefix(function, "glTextureImage"), strings.HasPrefix(function, "glTextureSubImage"),
+		strings.HasPrefix(function, "glCompressedTex"):
+	default:
+		return 0, false
+	}
+
+	// multisample images are allocated, never uploaded
+	if strings.Contains(function, "Multisample") {
+		return 0, false
+	}
+
+	name := "pixels"
+
+	if compressed {
+		name = "data"
+	}
+
+	pixels := call.Arg(name)
+
+	if pixels.IsNull() {
+		return 0, false
+	}
+
+	if pixels.Kind == BlobValue && pixels.Size != nil {
+		return *pixels.Size, true
+	}
+
+	if compressed {
+		bytes, _ := call.Arg("imageSize").Integer()
+
+		return bytes, true
+	}
+
+	texels := int64(1)
+
+	for _, dimension := range []string{"width", "height", "depth"} {
+		if value := call.Arg(dimension); value != nil {
+			n, _ := value.Integer()
+			texels *= n
+		}
+	}
+
+	return texels * texelSize(call.Arg("format"), call.Arg("type")), true
+}
+
+// the bytes in one texel of client memory, ignoring row alignment
+func texelSize(format, pixelType *Value) int64 {
+	if format == nil || pixelType == nil {
+		return 0
+	}
+
+	switch pixelType.Symbol {
+	case "GL_UNSIGNED_BYTE_3_3_2", "GL_UNSIGNED_BYTE_2_3_3_REV":
+		return 1
+	case "GL_UNSIGNED_SHORT_5_6_5", "GL_UNSIGNED_SHORT_5_6_5_REV", "GL_UNSIGNED_SHORT_4_4_4_4",
+		"GL_UNSIGNED_SHORT_4_4_4_4_REV", "GL_UNSIGNED_SHORT_5_5_5_1", "GL_UNSIGNED_SHORT_1_5_5_5_REV":
+		return 2
+	case "GL_UNSIGNED_INT_8_8_8_8", "GL_UNSIGNED_INT_8_8_8_8_REV", "GL_UNSIGNED_INT_10_10_10_2",
+		"GL_UNSIGNED_INT_2_10_10_10_REV", "GL_UNSIGNED_INT_24_8", "GL_UNSIGNED_INT_10F_11F_11F_REV",
+		"GL_UNSIGNED_INT_5_9_9_9_REV":
+		return 4
+	case "GL_FLOAT_32_UNSIGNED_INT_24_8_REV":
+		return 8
+	}
+
+	var componentSize int64
+
+	switch pixelType.Symbol {
+	case "GL_UNSIGNED_BYTE", "GL_BYTE":
+		componentSize = 1
+	case "GL_UNSIGNED_SHORT", "GL_SHORT", "GL_HALF_FLOAT", "GL_HALF_FLOAT_OES":
+		componentSize = 2
+	case "GL_UNSIGNED_INT", "GL_INT", "GL_FLOAT":
+		componentSize = 4
+	default:
+		return 0
+	}
+
+	components := int64(1)
+
+	switch strings.TrimSuffix(format.Symbol, "_INTEGER") {
+	case "GL_RG", "GL_LUMINANCE_ALPHA", "GL_DEPTH_STENCIL":
+		components = 2
+	case "GL_RGB", "GL_BGR":
+		components = 3
+	case "GL_RGBA", "GL_BGRA":
+		components = 4
+	}
+
+	return components * componentSize
+}
@@ -0,0 +1,192 @@
+package parsers
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	FrameNode  = "frame"
+	GroupNode  = "group"
+	MarkerNode = "marker"
+)
+
+// The position of the label argument in each call that opens a debug group
+var groupPushes = map[string]int{
+	"glPushDebugGroup":     3,
+	"glPushDebugGroupKHR":  3,
+	"glPushGroupMarkerEXT": 1,
+}
+
+var groupPops = map[string]bool{
+	"glPopDebugGroup":     true,
+	"glPopDebugGroupKHR":  true,
+	"glPopGroupMarkerEXT": true,
+}
+
+// The position of the label argument in each call that inserts a single marker
+var markerInserts = map[string]int{
+	"glDebugMessageInsert":    5,
+	"glDebugMessageInsertARB": 5,
+	"glDebugMessageInsertKHR": 5,
+	"glInsertEventMarkerEXT":  1,
+	"glStringMarkerGREMEDY":   1,
+}
+
+// A frame, debug group or marker, with the number of calls and draws made inside it and the calls it spans
+type TreeNode struct {
+	Name      string      `json:"name"`
+	Kind      string      `json:"kind"`
+	FirstCall int         `json:"firstCall"`
+	LastCall  int         `json:"lastCall"`
+	Calls     int         `json:"calls"`
+	Draws     int         `json:"draws"`
+	Children  []*TreeNode `json:"children,omitempty"`
+
+	// the group was opened in an earlier frame and was still open when this one began
+	Continued bool `json:"continued,omitempty"`
+
+	// the frame ended before the group was closed
+	Open bool `json:"open,omitempty"`
+}
+
+// Builds the call tree of each frame in turn. Debug groups belong to a context and can be left open across the end
+// of a frame, so the builder carries each context's open groups on to the next frame
+type TreeBuilder struct {
+	open map[string][]string
+}
+
+func NewTreeBuilder() *TreeBuilder {
+	return &TreeBuilder{open: map[string][]string{}}
+}
+
+// Nest the calls of a frame under the debug groups open when they were made, with markers as leaves
+func (b *TreeBuilder) Build(frame *Frame) *TreeNode {
+	root := &TreeNode{
+		Name:      "Frame " + strconv.Itoa(frame.ID),
+		Kind:      FrameNode,
+		FirstCall: frame.FirstCall,
+		LastCall:  frame.LastCall,
+	}
+
+	stacks := map[string][]*TreeNode{}
+
+	for context, names := range b.open {
+		for _, name := range names {
+			node := &TreeNode{Name: name, Kind: GroupNode, FirstCall: -1, Continued: true}
+			stacks[context] = append(stacks[context], node)
+		}
+	}
+
+	for _, call := range frame.Calls {
+		number, _ := strconv.Atoi(call.ID)
+
+		stack := stacks[call.Context]
+
+		if index, ok := groupPushes[call.FunctionName]; ok {
+			group := &TreeNode{Name: label(call, index), Kind: GroupNode, FirstCall: number}
+			stack = append(stack, group)
+		}
+
+		isDraw := IsDrawCall(call.FunctionName)
+
+		root.count(number, isDraw)
+
+		for _, node := range stack {
+			node.count(number, isDraw)
+		}
+
+		if index, ok := markerInserts[call.FunctionName]; ok {
+			marker := &TreeNode{Name: label(call, index), Kind: MarkerNode, FirstCall: number}
+			marker.count(number, false)
+
+			attach(root, stack, marker)
+		}
+
+		if groupPops[call.FunctionName] && len(stack) > 0 {
+			closed := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			attach(root, stack, closed)
+		}
+
+		stacks[call.Context] = stack
+	}
+
+	// whatever is still open at the end of the frame is attached as it stands and picked up again in the next frame
+	b.open = map[string][]string{}
+
+	contexts := make([]string, 0, len(stacks))
+
+	for context := range stacks {
+		contexts = append(contexts, context)
+	}
+
+	sort.Strings(contexts)
+
+	for _, context := range contexts {
+		stack := stacks[context]
+
+		for i := len(stack) - 1; i >= 0; i-- {
+			stack[i].Open = true
+
+			if stack[i].FirstCall < 0 {
+				stack[i].FirstCall = root.FirstCall
+				stack[i].LastCall = root.FirstCall
+			}
+
+			attach(root, stack[:i], stack[i])
+		}
+
+		for _, node := range stack {
+			b.open[context] = append(b.open[context], node.Name)
+		}
+	}
+
+	return root
+}
+
+// Build the tree of a single frame, without knowing which groups earlier frames left open
+func BuildTree(frame *Frame) *TreeNode {
+	return NewTreeBuilder().Build(frame)
+}
+
+func (node *TreeNode) count(number int, isDraw bool) {
+	if node.Calls == 0 || node.FirstCall < 0 || number < node.FirstCall {
+		node.FirstCall = number
+	}
+
+	if node.Calls == 0 || number > node.LastCall {
+		node.LastCall = number
+	}
+
+	node.Calls++
+
+	if isDraw {
+		node.Draws++
+	}
+}
+
+// add a finished node under the innermost open group, or the frame itself
+func attach(root *TreeNode, stack []*TreeNode, node *TreeNode) {
+	parent := root
+
+	if len(stack) > 0 {
+		parent = stack[len(stack)-1]
+	}
+
+	parent.Children = append(parent.Children, node)
+}
+
+func label(call *Call, index int) string {
+	if index < len(call.Args) && call.Args[index].Value != nil && call.Args[index].Value.String != nil {
+		return *call.Args[index].Value.String
+	}
+
+	if index < len(call.ParamValues) {
+		return strings.Trim(call.ParamValues[index], `"`)
+	}
+
+	return call.FunctionName
+}
@@ -0,0 +1,260 @@
+package parsers
+
+import (
+	"github.com/fergloragain/apitrace-remote/registry"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type ValueKind string
+
+const (
+	NullValue    ValueKind = "null"
+	BoolValue    ValueKind = "bool"
+	IntValue     ValueKind = "int"
+	UintValue    ValueKind = "uint"
+	FloatValue   ValueKind = "float"
+	EnumValue    ValueKind = "enum"
+	BitmaskValue ValueKind = "bitmask"
+	StringValue  ValueKind = "string"
+	PointerValue ValueKind = "pointer"
+	BlobValue    ValueKind = "blob"
+	ArrayValue   ValueKind = "array"
+	StructValue  ValueKind = "struct"
+	UnknownValue ValueKind = "unknown"
+)
+
+// A typed argument or return value. Text is always the value as apitrace writes it; which of the other fields are set
+// depends on the kind:
+//
+//	int, uint      Int or Uint
+//	float          Float, unless the value is infinite or NaN
+//	bool           Bool; GL_TRUE and GL_FALSE are enums, which Boolean reads as bools
+//	enum           Symbol, and Int when the symbol is a known GL, GLX or EGL enum
+//	bitmask        Symbols, and Uint when every flag is known
+//	string         String
+//	pointer        Uint
+//	blob           Size
+//	array          Elements; pointers to a single value are arrays of one element
+//	struct         Members
+type Value struct {
+	Kind     ValueKind `json:"kind"`
+	Text     string    `json:"text"`
+	Int      *int64    `json:"int,omitempty"`
+	Uint     *uint64   `json:"uint,omitempty"`
+	Float    *float64  `json:"float,omitempty"`
+	Bool     *bool     `json:"bool,omitempty"`
+	Symbol   string    `json:"symbol,omitempty"`
+	Symbols  []string  `json:"symbols,omitempty"`
+	String   *string   `json:"string,omitempty"`
+	Size     *int64    `json:"size,omitempty"`
+	Elements []*Value  `json:"elements,omitempty"`
+	Members  []*Member `json:"members,omitempty"`
+}
+
+type Member struct {
+	Name  string `json:"name"`
+	Value *Value `json:"value"`
+}
+
+// Argument names are empty when the dump was written without them
+type Argument struct {
+	Name  string `json:"name"`
+	Value *Value `json:"value"`
+}
+
+// Work out the type of a value from the syntax apitrace used to write it
+func NewValue(node Node) *Value {
+	v := &Value{Text: node.String()}
+
+	switch n := node.(type) {
+	case *LiteralNode:
+		literalValue(v, n)
+
+	case *BitmaskNode:
+		v.Kind = BitmaskValue
+
+		var mask uint64
+
+		known := true
+
+		for _, flag := range n.Flags {
+			flagValue := NewValue(flag)
+
+			switch {
+			case flagValue.Kind == EnumValue:
+				v.Symbols = append(v.Symbols, flagValue.Symbol)
+
+				if flagValue.Int != nil {
+					mask |= uint64(*flagValue.Int)
+				} else {
+					known = false
+				}
+			case flagValue.Uint != nil:
+				mask |= *flagValue.Uint
+			case flagValue.Int != nil:
+				mask |= uint64(*flagValue.Int)
+			default:
+				known = false
+			}
+		}
+
+		if known {
+			v.Uint = &mask
+		}
+
+	case *ReferenceNode:
+		v.Kind = ArrayValue
+		v.Elements = []*Value{NewValue(n.Value)}
+
+	case *ArrayNode:
+		v.Kind = ArrayValue
+		v.Elements = []*Value{}
+
+		for _, element := range n.Elements {
+			v.Elements = append(v.Elements, NewValue(element))
+		}
+
+	case *StructNode:
+		v.Kind = StructValue
+
+		for _, member := range n.Members {
+			v.Members = append(v.Members, &Member{member.Name, NewValue(member.Value)})
+		}
+
+	case *BlobNode:
+		v.Kind = BlobValue
+		size := n.Size
+		v.Size = &size
+
+	default:
+		v.Kind = UnknownValue
+	}
+
+	return v
+}
+
+func literalValue(v *Value, n *LiteralNode) {
+	switch n.Kind {
+	case StringLiteral, WideStringLiteral:
+		v.Kind = StringValue
+		str := n.Text
+		v.String = &str
+
+	case IdentifierLiteral:
+		identifierValue(v, n.Text)
+
+	case NumberLiteral:
+		numberValue(v, n.Text)
+
+	default:
+		v.Kind = UnknownValue
+	}
+}
+
+func identifierValue(v *Value, identifier string) {
+	switch identifier {
+	case "NULL":
+		v.Kind = NullValue
+
+	case "true", "True", "TRUE", "false", "False", "FALSE":
+		v.Kind = BoolValue
+		b := strings.HasSuffix(strings.ToLower(identifier), "true")
+		v.Bool = &b
+
+	default:
+		v.Kind = EnumValue
+		v.Symbol = identifier
+
+		if numeric, ok := registry.EnumValue(identifier); ok {
+			i := int64(numeric)
+			v.Int = &i
+		}
+	}
+}
+
+// apitrace writes pointers and leftover bitmask bits in hexadecimal, integers in decimal, and floats the way a C++
+// stream does, including inf and nan
+func numberValue(v *Value, text string) {
+	if strings.HasPrefix(text, "0x") {
+		if u, err := strconv.ParseUint(text[2:], 16, 64); err == nil {
+			v.Kind = PointerValue
+			v.Uint = &u
+			return
+		}
+	}
+
+	if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+		v.Kind = IntValue
+		v.Int = &i
+		return
+	}
+
+	if u, err := strconv.ParseUint(text, 10, 64); err == nil {
+		v.Kind = UintValue
+		v.Uint = &u
+		return
+	}
+
+	v.Kind = FloatValue
+
+	// JSON has no representation for infinities and NaNs, so those are only kept in the text
+	if f, err := strconv.ParseFloat(text, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		v.Float = &f
+	}
+}
+
+// The value of the argument with this name, or nil if the call has none
+func (call *Call) Arg(name string) *Value {
+	for _, arg := range call.Args {
+		if arg.Name == name {
+			return arg.Value
+		}
+	}
+
+	return nil
+}
+
+// The value as a whole number, if it is one; enums with a known value count
+func (v *Value) Integer() (int64, bool) {
+	switch {
+	case v == nil:
+		return 0, false
+	case v.Int != nil:
+		return *v.Int, true
+	case v.Uint != nil && *v.Uint <= math.MaxInt64:
+		return int64(*v.Uint), true
+	}
+
+	return 0, false
+}
+
+// the enums GL and EGL stand for booleans with
+var booleanEnums = map[string]bool{
+	"GL_TRUE":   true,
+	"GL_FALSE":  false,
+	"EGL_TRUE":  true,
+	"EGL_FALSE": false,
+}
+
+// The value as a bool, if it is one; GL_TRUE, GL_FALSE, EGL_TRUE and EGL_FALSE count
+func (v *Value) Boolean() (bool, bool) {
+	switch {
+	case v == nil:
+		return false, false
+	case v.Kind == BoolValue && v.Bool != nil:
+		return *v.Bool, true
+	case v.Kind == EnumValue:
+		b, ok := booleanEnums[v.Symbol]
+
+		return b, ok
+	}
+
+	return false, false
+}
+
+// Whether the value is a NULL pointer
+func (v *Value) IsNull() bool {
+	return v == nil || v.Kind == NullValue || (v.Kind == PointerValue && v.Uint != nil && *v.Uint == 0)
+}
@@ -0,0 +1,62 @@
+package parsers
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBooleans(t *testing.T) {
+	tests := []struct {
+		value string
+
+		// the kind, symbol and number the value is parsed into
+		kind   ValueKind
+		symbol string
+		number string
+
+		// what Boolean reads it as, if anything
+		boolean string
+	}{
+		// GL_TRUE and GL_FALSE stay enums, so searches and portability checks see the symbols
+		{"GL_TRUE", EnumValue, "GL_TRUE", "1", "true"},
+		{"GL_FALSE", EnumValue, "GL_FALSE", "0", "false"},
+		{"EGL_TRUE", EnumValue, "EGL_TRUE", "1", "true"},
+		{"EGL_FALSE", EnumValue, "EGL_FALSE", "0", "false"},
+
+		// Xlib and C bools
+		{"True", BoolValue, "", "-", "true"},
+		{"false", BoolValue, "", "-", "false"},
+
+		{"GL_TEXTURE_2D", EnumValue, "GL_TEXTURE_2D", "3553", "-"},
+		{"1", IntValue, "", "1", "-"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			frames := ParseDump(fmt.Sprintf("0 glDepthMask(flag = %s)", test.value)).Frames
+
+			if len(frames) != 1 || len(frames[0].Calls) != 1 {
+				t.Fatalf("expected one call, got %v", frames)
+			}
+
+			value := frames[0].Calls[0].Arg("flag")
+
+			number := "-"
+
+			if i, ok := value.Integer(); ok {
+				number = fmt.Sprint(i)
+			}
+
+			boolean := "-"
+
+			if b, ok := value.Boolean(); ok {
+				boolean = fmt.Sprint(b)
+			}
+
+			if value.Kind != test.kind || value.Symbol != test.symbol || number != test.number || boolean != test.boolean {
+				t.Errorf("expected %s %s %s read as %s, got %s %s %s read as %s", test.kind, test.symbol, test.number,
+					test.boolean, value.Kind, value.Symbol, number, boolean)
+			}
+		})
+	}
+}
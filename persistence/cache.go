@@ -91,9 +91,6 @@ func (cache *Cache) GetValidID(key string) string {
 
 			iter++
 		}
-
-		return nil
-
 	})
 
 	if err != nil {
@@ -0,0 +1,290 @@
+// Package portability works out which versions of GL, GLES, GLX and EGL a trace can run on, from the functions and enums
+// its calls use, along with the extensions it relies on and what it uses that the core profile of GL removed
+package portability
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/registry"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	Function = "function"
+	Enum     = "enum"
+)
+
+// A function or enum a trace uses, with the first call using it, the earliest version of each API declaring it, and
+// the extensions it comes from when no version does
+type Symbol struct {
+	Name            string   `json:"name"`
+	Kind            string   `json:"kind"`
+	Calls           int      `json:"calls"`
+	FirstCall       string   `json:"firstCall"`
+	FirstFrame      int      `json:"firstFrame"`
+	Versions        []string `json:"versions,omitempty"`
+	Extensions      []string `json:"extensions,omitempty"`
+	RemovedFromCore bool     `json:"removedFromCore,omitempty"`
+
+	versions map[string]registry.Version
+	known    bool
+}
+
+// The lowest version of an API with every function and enum of the trace it declares, the symbols that need that
+// version, and the symbols no version of the API has
+type Requirement struct {
+	API        string   `json:"api"`
+	Version    string   `json:"version"`
+	RequiredBy []string `json:"requiredBy"`
+	Missing    []string `json:"missing,omitempty"`
+}
+
+// An extension the trace relies on, through functions and enums no version has
+type ExtensionUse struct {
+	Extension string   `json:"extension"`
+	Calls     int      `json:"calls"`
+	Symbols   []string `json:"symbols"`
+}
+
+// Unknown holds the functions and enums the registry doesn't have, such as the memcpy calls apitrace records writes to
+// mapped buffers with
+type Report struct {
+	Requirements []*Requirement  `json:"requirements"`
+	Extensions   []*ExtensionUse `json:"extensions"`
+	Deprecated   []*Symbol       `json:"deprecated"`
+	Symbols      []*Symbol       `json:"symbols,omitempty"`
+	Unknown      []string        `json:"unknown"`
+}
+
+// Collects the functions and enums a trace uses a frame at a time, as it is dumped
+type Checker struct {
+	symbols map[string]*Symbol
+}
+
+func NewChecker() *Checker {
+	return &Checker{symbols: map[string]*Symbol{}}
+}
+
+// Look up the functions and enums the calls of a frame use
+func (c *Checker) Add(frame *parsers.Frame) {
+	for _, call := range frame.Calls {
+		c.use(frame.ID, call, Function, call.FunctionName)
+
+		// an enum passed twice to one call is counted once
+		enums := map[string]bool{}
+
+		for _, arg := range call.Args {
+			addEnums(enums, arg.Value)
+		}
+
+		for enum := range enums {
+			c.use(frame.ID, call, Enum, enum)
+		}
+	}
+}
+
+func (c *Checker) use(frame int, call *parsers.Call, kind, name string) {
+	symbol, ok := c.symbols[name]
+
+	if !ok {
+		symbol = newSymbol(kind, name)
+		symbol.FirstCall = call.ID
+		symbol.FirstFrame = frame
+
+		c.symbols[name] = symbol
+	}
+
+	symbol.Calls++
+}
+
+func newSymbol(kind, name string) *Symbol {
+	symbol := &Symbol{Name: name, Kind: kind, versions: map[string]registry.Version{}}
+
+	features := registry.FunctionFeatures(name)
+	symbol.Extensions = registry.FunctionExtensions(name)
+
+	if kind == Enum {
+		features = registry.EnumFeatures(name)
+		symbol.Extensions = registry.EnumExtensions(name)
+	}
+
+	symbol.known = len(features) > 0
+	symbol.RemovedFromCore = registry.RemovedFromCore(name)
+
+	for _, feature := range features {
+		version, ok := registry.ParseVersion(feature)
+
+		if !ok {
+			continue
+		}
+
+		if earliest, ok := symbol.versions[version.API]; !ok || version.Before(earliest) {
+			symbol.versions[version.API] = version
+		}
+	}
+
+	for _, version := range symbol.versions {
+		symbol.Versions = append(symbol.Versions, version.String())
+	}
+
+	sort.Strings(symbol.Versions)
+
+	return symbol
+}
+
+// The requirements of the calls added so far
+func (c *Checker) Report() *Report {
+	report := &Report{
+		Requirements: []*Requirement{},
+		Extensions:   []*ExtensionUse{},
+		Deprecated:   []*Symbol{},
+		Symbols:      []*Symbol{},
+		Unknown:      []string{},
+	}
+
+	extensions := map[string]*ExtensionUse{}
+	apis := map[string]bool{}
+
+	for _, symbol := range c.symbols {
+		if !symbol.known {
+			report.Unknown = append(report.Unknown, symbol.Name)
+			continue
+		}
+
+		report.Symbols = append(report.Symbols, symbol)
+
+		if symbol.RemovedFromCore {
+			report.Deprecated = append(report.Deprecated, symbol)
+		}
+
+		for _, extension := range symbol.Extensions {
+			use, ok := extensions[extension]
+
+			if !ok {
+				use = &ExtensionUse{Extension: extension}
+				extensions[extension] = use
+				report.Extensions = append(report.Extensions, use)
+			}
+
+			use.Calls += symbol.Calls
+			use.Symbols = append(use.Symbols, symbol.Name)
+		}
+
+		for api := range symbol.versions {
+			apis[api] = true
+		}
+	}
+
+	sort.Slice(report.Symbols, func(i, j int) bool {
+		return report.Symbols[i].Name < report.Symbols[j].Name
+	})
+
+	sort.Slice(report.Deprecated, func(i, j int) bool {
+		return callOrder(report.Deprecated[i].FirstCall, report.Deprecated[j].FirstCall)
+	})
+
+	sort.Slice(report.Extensions, func(i, j int) bool {
+		return report.Extensions[i].Extension < report.Extensions[j].Extension
+	})
+
+	for _, use := range report.Extensions {
+		sort.Strings(use.Symbols)
+	}
+
+	sort.Strings(report.Unknown)
+
+	for _, api := range []string{registry.GL, registry.GLES, registry.GLES1, registry.GLX, registry.EGL} {
+		if apis[api] {
+			report.Requirements = append(report.Requirements, requirement(api, report.Symbols))
+		}
+	}
+
+	return report
+}
+
+// The lowest version of an API with everything the trace uses from it. Only the symbols of the API's family are
+// weighed, so GLX functions don't count against GL, nor GL functions against EGL
+func requirement(api string, symbols []*Symbol) *Requirement {
+	var minimum registry.Version
+
+	for _, symbol := range symbols {
+		if version, ok := symbol.versions[api]; ok && minimum.Before(version) {
+			minimum = version
+		}
+	}
+
+	result := &Requirement{API: api, Version: minimum.String(), RequiredBy: []string{}}
+
+	for _, symbol := range symbols {
+		if family(symbol.Name) != apiFamily(api) {
+			continue
+		}
+
+		version, ok := symbol.versions[api]
+
+		switch {
+		case ok && version == minimum:
+			result.RequiredBy = append(result.RequiredBy, symbol.Name)
+
+		// symbols only extensions declare are listed with the extensions
+		case !ok && len(symbol.versions) > 0:
+			result.Missing = append(result.Missing, symbol.Name)
+		}
+	}
+
+	return result
+}
+
+func apiFamily(api string) string {
+	switch api {
+	case registry.GLX, registry.EGL:
+		return api
+	}
+
+	return registry.GL
+}
+
+func family(name string) string {
+	switch {
+	case strings.HasPrefix(name, "glX"), strings.HasPrefix(name, "GLX_"):
+		return registry.GLX
+	case strings.HasPrefix(name, "egl"), strings.HasPrefix(name, "EGL_"):
+		return registry.EGL
+	}
+
+	return registry.GL
+}
+
+func addEnums(enums map[string]bool, value *parsers.Value) {
+	if value == nil {
+		return
+	}
+
+	switch value.Kind {
+	case parsers.EnumValue:
+		enums[value.Symbol] = true
+
+	case parsers.BitmaskValue:
+		for _, symbol := range value.Symbols {
+			enums[symbol] = true
+		}
+
+	case parsers.ArrayValue:
+		for _, element := range value.Elements {
+			addEnums(enums, element)
+		}
+
+	case parsers.StructValue:
+		for _, member := range value.Members {
+			addEnums(enums, member.Value)
+		}
+	}
+}
+
+func callOrder(a, b string) bool {
+	x, _ := strconv.Atoi(a)
+	y, _ := strconv.Atoi(b)
+
+	return x < y
+}
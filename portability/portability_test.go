@@ -0,0 +1,114 @@
+package portability
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const dump = `0 glXMakeCurrent(dpy = 0x5581ac2f06b0, drawable = 62914562, ctx = 0x5581ac3d1e40) = True
+1 glBegin(mode = GL_TRIANGLES)
+2 glVertex2f(x = 0, y = 0)
+3 glEnd()
+4 glGenBuffers(n = 1, buffers = &1)
+5 glBindBuffer(target = GL_ARRAY_BUFFER, buffer = 1)
+6 glGetTextureHandleARB(texture = 2) = 4294969856
+7 memcpy(dest = 0x7f0c3c0a1000, src = blob(16), n = 16)
+8 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+9 eglSwapBuffers(dpy = 0x55e1d2a2c0f0, surface = 0x55e1d2a6b1a0) = EGL_TRUE`
+
+func TestSymbols(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+
+		// the extensions the symbol comes from, and whether the core profile removed it
+		extensions []string
+		removed    bool
+	}{
+		// GL 1.0 has no feature of its own in the headers, but does in the registry
+		{name: "glBegin", versions: []string{"GL 1.0"}, removed: true},
+		{name: "glVertex2f", versions: []string{"GL 1.0"}, removed: true},
+		{name: "GL_TRIANGLES", versions: []string{"GL 1.0", "GLES 1.0", "GLES 2.0"}},
+		{name: "glGenBuffers", versions: []string{"GL 1.5", "GLES 1.0", "GLES 2.0"}},
+		{name: "GL_ARRAY_BUFFER", versions: []string{"GL 1.5", "GLES 1.0", "GLES 2.0"}},
+		{name: "glGetTextureHandleARB", extensions: []string{"GL_ARB_bindless_texture"}},
+		{name: "glXMakeCurrent", versions: []string{"GLX 1.0"}},
+		{name: "glXSwapBuffers", versions: []string{"GLX 1.0"}},
+		{name: "eglSwapBuffers", versions: []string{"EGL 1.0"}},
+	}
+
+	checker := NewChecker()
+
+	for _, frame := range parsers.ParseDump(dump).Frames {
+		checker.Add(frame)
+	}
+
+	report := checker.Report()
+
+	symbols := map[string]*Symbol{}
+
+	for _, symbol := range report.Symbols {
+		symbols[symbol.Name] = symbol
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			symbol, ok := symbols[test.name]
+
+			if !ok {
+				t.Fatalf("expected %s to be in the registry", test.name)
+			}
+
+			if !reflect.DeepEqual(symbol.Versions, test.versions) {
+				t.Errorf("expected the versions %v, got %v", test.versions, symbol.Versions)
+			}
+
+			if !reflect.DeepEqual(symbol.Extensions, test.extensions) {
+				t.Errorf("expected the extensions %v, got %v", test.extensions, symbol.Extensions)
+			}
+
+			if symbol.RemovedFromCore != test.removed {
+				t.Errorf("expected removed from core to be %t, got %t", test.removed, symbol.RemovedFromCore)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(report.Unknown, []string{"memcpy"}) {
+		t.Errorf("expected only memcpy to be unknown, got %v", report.Unknown)
+	}
+}
+
+func TestRequirements(t *testing.T) {
+	checker := NewChecker()
+
+	for _, frame := range parsers.ParseDump(dump).Frames {
+		checker.Add(frame)
+	}
+
+	requirements := []string{}
+
+	for _, requirement := range checker.Report().Requirements {
+		description := requirement.Version + " for " + strings.Join(requirement.RequiredBy, " ")
+
+		if len(requirement.Missing) > 0 {
+			description += " without " + strings.Join(requirement.Missing, " ")
+		}
+
+		requirements = append(requirements, description)
+	}
+
+	// GLES has no immediate mode, and GLES 1.0 and 2.0 both have buffers
+	want := []string{
+		"GL 1.5 for GL_ARRAY_BUFFER glBindBuffer glGenBuffers",
+		"GLES 2.0 for GL_ARRAY_BUFFER GL_TRIANGLES glBindBuffer glGenBuffers without glBegin glEnd glVertex2f",
+		"GLES 1.0 for GL_ARRAY_BUFFER GL_TRIANGLES glBindBuffer glGenBuffers without glBegin glEnd glVertex2f",
+		"GLX 1.0 for glXMakeCurrent glXSwapBuffers",
+		"EGL 1.0 for eglSwapBuffers",
+	}
+
+	if !reflect.DeepEqual(requirements, want) {
+		t.Errorf("expected the requirements\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(requirements, "\n"))
+	}
+}
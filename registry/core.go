@@ -0,0 +1,872 @@
+// Code generated by gen.go from the Khronos XML registries; DO NOT EDIT.
+
+package registry
+
+var removedFromCore = map[string]bool{
+	"GL_2D":                                   true,
+	"GL_2_BYTES":                              true,
+	"GL_3D":                                   true,
+	"GL_3D_COLOR":                             true,
+	"GL_3D_COLOR_TEXTURE":                     true,
+	"GL_3_BYTES":                              true,
+	"GL_4D_COLOR_TEXTURE":                     true,
+	"GL_4_BYTES":                              true,
+	"GL_ACCUM":                                true,
+	"GL_ACCUM_ALPHA_BITS":                     true,
+	"GL_ACCUM_BLUE_BITS":                      true,
+	"GL_ACCUM_BUFFER_BIT":                     true,
+	"GL_ACCUM_CLEAR_VALUE":                    true,
+	"GL_ACCUM_GREEN_BITS":                     true,
+	"GL_ACCUM_RED_BITS":                       true,
+	"GL_ADD":                                  true,
+	"GL_ADD_SIGNED":                           true,
+	"GL_ALIASED_POINT_SIZE_RANGE":             true,
+	"GL_ALL_ATTRIB_BITS":                      true,
+	"GL_ALPHA12":                              true,
+	"GL_ALPHA16":                              true,
+	"GL_ALPHA4":                               true,
+	"GL_ALPHA8":                               true,
+	"GL_ALPHA_BIAS":                           true,
+	"GL_ALPHA_BITS":                           true,
+	"GL_ALPHA_INTEGER":                        true,
+	"GL_ALPHA_SCALE":                          true,
+	"GL_ALPHA_TEST":                           true,
+	"GL_ALPHA_TEST_FUNC":                      true,
+	"GL_ALPHA_TEST_REF":                       true,
+	"GL_AMBIENT":                              true,
+	"GL_AMBIENT_AND_DIFFUSE":                  true,
+	"GL_ATTRIB_STACK_DEPTH":                   true,
+	"GL_AUTO_NORMAL":                          true,
+	"GL_AUX0":                                 true,
+	"GL_AUX1":                                 true,
+	"GL_AUX2":                                 true,
+	"GL_AUX3":                                 true,
+	"GL_AUX_BUFFERS":                          true,
+	"GL_BITMAP":                               true,
+	"GL_BITMAP_TOKEN":                         true,
+	"GL_BLUE_BIAS":                            true,
+	"GL_BLUE_BITS":                            true,
+	"GL_BLUE_SCALE":                           true,
+	"GL_C3F_V3F":                              true,
+	"GL_C4F_N3F_V3F":                          true,
+	"GL_C4UB_V2F":                             true,
+	"GL_C4UB_V3F":                             true,
+	"GL_CLAMP":                                true,
+	"GL_CLAMP_FRAGMENT_COLOR":                 true,
+	"GL_CLAMP_VERTEX_COLOR":                   true,
+	"GL_CLIENT_ACTIVE_TEXTURE":                true,
+	"GL_CLIENT_ALL_ATTRIB_BITS":               true,
+	"GL_CLIENT_ATTRIB_STACK_DEPTH":            true,
+	"GL_CLIENT_PIXEL_STORE_BIT":               true,
+	"GL_CLIENT_VERTEX_ARRAY_BIT":              true,
+	"GL_CLIP_PLANE0":                          true,
+	"GL_CLIP_PLANE1":                          true,
+	"GL_CLIP_PLANE2":                          true,
+	"GL_CLIP_PLANE3":                          true,
+	"GL_CLIP_PLANE4":                          true,
+	"GL_CLIP_PLANE5":                          true,
+	"GL_COEFF":                                true,
+	"GL_COLOR_ARRAY":                          true,
+	"GL_COLOR_ARRAY_BUFFER_BINDING":           true,
+	"GL_COLOR_ARRAY_POINTER":                  true,
+	"GL_COLOR_ARRAY_SIZE":                     true,
+	"GL_COLOR_ARRAY_STRIDE":                   true,
+	"GL_COLOR_ARRAY_TYPE":                     true,
+	"GL_COLOR_INDEX":                          true,
+	"GL_COLOR_INDEXES":                        true,
+	"GL_COLOR_MATERIAL":                       true,
+	"GL_COLOR_MATERIAL_FACE":                  true,
+	"GL_COLOR_MATERIAL_PARAMETER":             true,
+	"GL_COLOR_SUM":                            true,
+	"GL_COMBINE":                              true,
+	"GL_COMBINE_ALPHA":                        true,
+	"GL_COMBINE_RGB":                          true,
+	"GL_COMPARE_R_TO_TEXTURE":                 true,
+	"GL_COMPILE":                              true,
+	"GL_COMPILE_AND_EXECUTE":                  true,
+	"GL_COMPRESSED_ALPHA":                     true,
+	"GL_COMPRESSED_INTENSITY":                 true,
+	"GL_COMPRESSED_LUMINANCE":                 true,
+	"GL_COMPRESSED_LUMINANCE_ALPHA":           true,
+	"GL_COMPRESSED_SLUMINANCE":                true,
+	"GL_COMPRESSED_SLUMINANCE_ALPHA":          true,
+	"GL_CONSTANT":                             true,
+	"GL_CONSTANT_ATTENUATION":                 true,
+	"GL_COORD_REPLACE":                        true,
+	"GL_COPY_PIXEL_TOKEN":                     true,
+	"GL_CURRENT_BIT":                          true,
+	"GL_CURRENT_COLOR":                        true,
+	"GL_CURRENT_FOG_COORD":                    true,
+	"GL_CURRENT_FOG_COORDINATE":               true,
+	"GL_CURRENT_INDEX":                        true,
+	"GL_CURRENT_NORMAL":                       true,
+	"GL_CURRENT_RASTER_COLOR":                 true,
+	"GL_CURRENT_RASTER_DISTANCE":              true,
+	"GL_CURRENT_RASTER_INDEX":                 true,
+	"GL_CURRENT_RASTER_POSITION":              true,
+	"GL_CURRENT_RASTER_POSITION_VALID":        true,
+	"GL_CURRENT_RASTER_SECONDARY_COLOR":       true,
+	"GL_CURRENT_RASTER_TEXTURE_COORDS":        true,
+	"GL_CURRENT_SECONDARY_COLOR":              true,
+	"GL_CURRENT_TEXTURE_COORDS":               true,
+	"GL_DECAL":                                true,
+	"GL_DEPTH_BIAS":                           true,
+	"GL_DEPTH_BITS":                           true,
+	"GL_DEPTH_SCALE":                          true,
+	"GL_DEPTH_TEXTURE_MODE":                   true,
+	"GL_DIFFUSE":                              true,
+	"GL_DISPLAY_LIST":                         true,
+	"GL_DOMAIN":                               true,
+	"GL_DOT3_RGB":                             true,
+	"GL_DOT3_RGBA":                            true,
+	"GL_DRAW_PIXEL_TOKEN":                     true,
+	"GL_EDGE_FLAG":                            true,
+	"GL_EDGE_FLAG_ARRAY":                      true,
+	"GL_EDGE_FLAG_ARRAY_BUFFER_BINDING":       true,
+	"GL_EDGE_FLAG_ARRAY_POINTER":              true,
+	"GL_EDGE_FLAG_ARRAY_STRIDE":               true,
+	"GL_EMISSION":                             true,
+	"GL_ENABLE_BIT":                           true,
+	"GL_EVAL_BIT":                             true,
+	"GL_EXP":                                  true,
+	"GL_EXP2":                                 true,
+	"GL_EYE_LINEAR":                           true,
+	"GL_EYE_PLANE":                            true,
+	"GL_FEEDBACK":                             true,
+	"GL_FEEDBACK_BUFFER_POINTER":              true,
+	"GL_FEEDBACK_BUFFER_SIZE":                 true,
+	"GL_FEEDBACK_BUFFER_TYPE":                 true,
+	"GL_FLAT":                                 true,
+	"GL_FOG":                                  true,
+	"GL_FOG_BIT":                              true,
+	"GL_FOG_COLOR":                            true,
+	"GL_FOG_COORD":                            true,
+	"GL_FOG_COORDINATE":                       true,
+	"GL_FOG_COORDINATE_ARRAY":                 true,
+	"GL_FOG_COORDINATE_ARRAY_BUFFER_BINDING":  true,
+	"GL_FOG_COORDINATE_ARRAY_POINTER":         true,
+	"GL_FOG_COORDINATE_ARRAY_STRIDE":          true,
+	"GL_FOG_COORDINATE_ARRAY_TYPE":            true,
+	"GL_FOG_COORDINATE_SOURCE":                true,
+	"GL_FOG_COORD_ARRAY":                      true,
+	"GL_FOG_COORD_ARRAY_BUFFER_BINDING":       true,
+	"GL_FOG_COORD_ARRAY_POINTER":              true,
+	"GL_FOG_COORD_ARRAY_STRIDE":               true,
+	"GL_FOG_COORD_ARRAY_TYPE":                 true,
+	"GL_FOG_COORD_SRC":                        true,
+	"GL_FOG_DENSITY":                          true,
+	"GL_FOG_END":                              true,
+	"GL_FOG_HINT":                             true,
+	"GL_FOG_INDEX":                            true,
+	"GL_FOG_MODE":                             true,
+	"GL_FOG_START":                            true,
+	"GL_FRAGMENT_DEPTH":                       true,
+	"GL_GENERATE_MIPMAP":                      true,
+	"GL_GENERATE_MIPMAP_HINT":                 true,
+	"GL_GREEN_BIAS":                           true,
+	"GL_GREEN_BITS":                           true,
+	"GL_GREEN_SCALE":                          true,
+	"GL_HINT_BIT":                             true,
+	"GL_INDEX":                                true,
+	"GL_INDEX_ARRAY":                          true,
+	"GL_INDEX_ARRAY_BUFFER_BINDING":           true,
+	"GL_INDEX_ARRAY_POINTER":                  true,
+	"GL_INDEX_ARRAY_STRIDE":                   true,
+	"GL_INDEX_ARRAY_TYPE":                     true,
+	"GL_INDEX_BITS":                           true,
+	"GL_INDEX_CLEAR_VALUE":                    true,
+	"GL_INDEX_LOGIC_OP":                       true,
+	"GL_INDEX_MODE":                           true,
+	"GL_INDEX_OFFSET":                         true,
+	"GL_INDEX_SHIFT":                          true,
+	"GL_INDEX_WRITEMASK":                      true,
+	"GL_INTENSITY":                            true,
+	"GL_INTENSITY12":                          true,
+	"GL_INTENSITY16":                          true,
+	"GL_INTENSITY4":                           true,
+	"GL_INTENSITY8":                           true,
+	"GL_INTERPOLATE":                          true,
+	"GL_LIGHT0":                               true,
+	"GL_LIGHT1":                               true,
+	"GL_LIGHT2":                               true,
+	"GL_LIGHT3":                               true,
+	"GL_LIGHT4":                               true,
+	"GL_LIGHT5":                               true,
+	"GL_LIGHT6":                               true,
+	"GL_LIGHT7":                               true,
+	"GL_LIGHTING":                             true,
+	"GL_LIGHTING_BIT":                         true,
+	"GL_LIGHT_MODEL_AMBIENT":                  true,
+	"GL_LIGHT_MODEL_COLOR_CONTROL":            true,
+	"GL_LIGHT_MODEL_LOCAL_VIEWER":             true,
+	"GL_LIGHT_MODEL_TWO_SIDE":                 true,
+	"GL_LINEAR_ATTENUATION":                   true,
+	"GL_LINE_BIT":                             true,
+	"GL_LINE_RESET_TOKEN":                     true,
+	"GL_LINE_STIPPLE":                         true,
+	"GL_LINE_STIPPLE_PATTERN":                 true,
+	"GL_LINE_STIPPLE_REPEAT":                  true,
+	"GL_LINE_TOKEN":                           true,
+	"GL_LIST_BASE":                            true,
+	"GL_LIST_BIT":                             true,
+	"GL_LIST_INDEX":                           true,
+	"GL_LIST_MODE":                            true,
+	"GL_LOAD":                                 true,
+	"GL_LOGIC_OP":                             true,
+	"GL_LUMINANCE":                            true,
+	"GL_LUMINANCE12":                          true,
+	"GL_LUMINANCE12_ALPHA12":                  true,
+	"GL_LUMINANCE12_ALPHA4":                   true,
+	"GL_LUMINANCE16":                          true,
+	"GL_LUMINANCE16_ALPHA16":                  true,
+	"GL_LUMINANCE4":                           true,
+	"GL_LUMINANCE4_ALPHA4":                    true,
+	"GL_LUMINANCE6_ALPHA2":                    true,
+	"GL_LUMINANCE8":                           true,
+	"GL_LUMINANCE8_ALPHA8":                    true,
+	"GL_LUMINANCE_ALPHA":                      true,
+	"GL_MAP1_COLOR_4":                         true,
+	"GL_MAP1_GRID_DOMAIN":                     true,
+	"GL_MAP1_GRID_SEGMENTS":                   true,
+	"GL_MAP1_INDEX":                           true,
+	"GL_MAP1_NORMAL":                          true,
+	"GL_MAP1_TEXTURE_COORD_1":                 true,
+	"GL_MAP1_TEXTURE_COORD_2":                 true,
+	"GL_MAP1_TEXTURE_COORD_3":                 true,
+	"GL_MAP1_TEXTURE_COORD_4":                 true,
+	"GL_MAP1_VERTEX_3":                        true,
+	"GL_MAP1_VERTEX_4":                        true,
+	"GL_MAP2_COLOR_4":                         true,
+	"GL_MAP2_GRID_DOMAIN":                     true,
+	"GL_MAP2_GRID_SEGMENTS":                   true,
+	"GL_MAP2_INDEX":                           true,
+	"GL_MAP2_NORMAL":                          true,
+	"GL_MAP2_TEXTURE_COORD_1":                 true,
+	"GL_MAP2_TEXTURE_COORD_2":                 true,
+	"GL_MAP2_TEXTURE_COORD_3":                 true,
+	"GL_MAP2_TEXTURE_COORD_4":                 true,
+	"GL_MAP2_VERTEX_3":                        true,
+	"GL_MAP2_VERTEX_4":                        true,
+	"GL_MAP_COLOR":                            true,
+	"GL_MAP_STENCIL":                          true,
+	"GL_MATRIX_MODE":                          true,
+	"GL_MAX_ATTRIB_STACK_DEPTH":               true,
+	"GL_MAX_CLIENT_ATTRIB_STACK_DEPTH":        true,
+	"GL_MAX_CLIP_PLANES":                      true,
+	"GL_MAX_EVAL_ORDER":                       true,
+	"GL_MAX_LIGHTS":                           true,
+	"GL_MAX_LIST_NESTING":                     true,
+	"GL_MAX_MODELVIEW_STACK_DEPTH":            true,
+	"GL_MAX_NAME_STACK_DEPTH":                 true,
+	"GL_MAX_PIXEL_MAP_TABLE":                  true,
+	"GL_MAX_PROJECTION_STACK_DEPTH":           true,
+	"GL_MAX_TEXTURE_COORDS":                   true,
+	"GL_MAX_TEXTURE_STACK_DEPTH":              true,
+	"GL_MAX_TEXTURE_UNITS":                    true,
+	"GL_MODELVIEW":                            true,
+	"GL_MODELVIEW_MATRIX":                     true,
+	"GL_MODELVIEW_STACK_DEPTH":                true,
+	"GL_MODULATE":                             true,
+	"GL_MULT":                                 true,
+	"GL_MULTISAMPLE_BIT":                      true,
+	"GL_N3F_V3F":                              true,
+	"GL_NAME_STACK_DEPTH":                     true,
+	"GL_NORMALIZE":                            true,
+	"GL_NORMAL_ARRAY":                         true,
+	"GL_NORMAL_ARRAY_BUFFER_BINDING":          true,
+	"GL_NORMAL_ARRAY_POINTER":                 true,
+	"GL_NORMAL_ARRAY_STRIDE":                  true,
+	"GL_NORMAL_ARRAY_TYPE":                    true,
+	"GL_NORMAL_MAP":                           true,
+	"GL_OBJECT_LINEAR":                        true,
+	"GL_OBJECT_PLANE":                         true,
+	"GL_OPERAND0_ALPHA":                       true,
+	"GL_OPERAND0_RGB":                         true,
+	"GL_OPERAND1_ALPHA":                       true,
+	"GL_OPERAND1_RGB":                         true,
+	"GL_OPERAND2_ALPHA":                       true,
+	"GL_OPERAND2_RGB":                         true,
+	"GL_ORDER":                                true,
+	"GL_PASS_THROUGH_TOKEN":                   true,
+	"GL_PERSPECTIVE_CORRECTION_HINT":          true,
+	"GL_PIXEL_MAP_A_TO_A":                     true,
+	"GL_PIXEL_MAP_A_TO_A_SIZE":                true,
+	"GL_PIXEL_MAP_B_TO_B":                     true,
+	"GL_PIXEL_MAP_B_TO_B_SIZE":                true,
+	"GL_PIXEL_MAP_G_TO_G":                     true,
+	"GL_PIXEL_MAP_G_TO_G_SIZE":                true,
+	"GL_PIXEL_MAP_I_TO_A":                     true,
+	"GL_PIXEL_MAP_I_TO_A_SIZE":                true,
+	"GL_PIXEL_MAP_I_TO_B":                     true,
+	"GL_PIXEL_MAP_I_TO_B_SIZE":                true,
+	"GL_PIXEL_MAP_I_TO_G":                     true,
+	"GL_PIXEL_MAP_I_TO_G_SIZE":                true,
+	"GL_PIXEL_MAP_I_TO_I":                     true,
+	"GL_PIXEL_MAP_I_TO_I_SIZE":                true,
+	"GL_PIXEL_MAP_I_TO_R":                     true,
+	"GL_PIXEL_MAP_I_TO_R_SIZE":                true,
+	"GL_PIXEL_MAP_R_TO_R":                     true,
+	"GL_PIXEL_MAP_R_TO_R_SIZE":                true,
+	"GL_PIXEL_MAP_S_TO_S":                     true,
+	"GL_PIXEL_MAP_S_TO_S_SIZE":                true,
+	"GL_PIXEL_MODE_BIT":                       true,
+	"GL_POINT_BIT":                            true,
+	"GL_POINT_DISTANCE_ATTENUATION":           true,
+	"GL_POINT_SIZE_MAX":                       true,
+	"GL_POINT_SIZE_MIN":                       true,
+	"GL_POINT_SMOOTH":                         true,
+	"GL_POINT_SMOOTH_HINT":                    true,
+	"GL_POINT_SPRITE":                         true,
+	"GL_POINT_TOKEN":                          true,
+	"GL_POLYGON":                              true,
+	"GL_POLYGON_BIT":                          true,
+	"GL_POLYGON_STIPPLE":                      true,
+	"GL_POLYGON_STIPPLE_BIT":                  true,
+	"GL_POLYGON_TOKEN":                        true,
+	"GL_POSITION":                             true,
+	"GL_PREVIOUS":                             true,
+	"GL_PRIMARY_COLOR":                        true,
+	"GL_PROJECTION":                           true,
+	"GL_PROJECTION_MATRIX":                    true,
+	"GL_PROJECTION_STACK_DEPTH":               true,
+	"GL_Q":                                    true,
+	"GL_QUADRATIC_ATTENUATION":                true,
+	"GL_QUAD_STRIP":                           true,
+	"GL_R":                                    true,
+	"GL_RED_BIAS":                             true,
+	"GL_RED_BITS":                             true,
+	"GL_RED_SCALE":                            true,
+	"GL_REFLECTION_MAP":                       true,
+	"GL_RENDER":                               true,
+	"GL_RENDER_MODE":                          true,
+	"GL_RESCALE_NORMAL":                       true,
+	"GL_RETURN":                               true,
+	"GL_RGBA_MODE":                            true,
+	"GL_RGB_SCALE":                            true,
+	"GL_S":                                    true,
+	"GL_SCISSOR_BIT":                          true,
+	"GL_SECONDARY_COLOR_ARRAY":                true,
+	"GL_SECONDARY_COLOR_ARRAY_BUFFER_BINDING": true,
+	"GL_SECONDARY_COLOR_ARRAY_POINTER":        true,
+	"GL_SECONDARY_COLOR_ARRAY_SIZE":           true,
+	"GL_SECONDARY_COLOR_ARRAY_STRIDE":         true,
+	"GL_SECONDARY_COLOR_ARRAY_TYPE":           true,
+	"GL_SELECT":                               true,
+	"GL_SELECTION_BUFFER_POINTER":             true,
+	"GL_SELECTION_BUFFER_SIZE":                true,
+	"GL_SEPARATE_SPECULAR_COLOR":              true,
+	"GL_SHADE_MODEL":                          true,
+	"GL_SHININESS":                            true,
+	"GL_SINGLE_COLOR":                         true,
+	"GL_SLUMINANCE":                           true,
+	"GL_SLUMINANCE8":                          true,
+	"GL_SLUMINANCE8_ALPHA8":                   true,
+	"GL_SLUMINANCE_ALPHA":                     true,
+	"GL_SMOOTH":                               true,
+	"GL_SOURCE0_ALPHA":                        true,
+	"GL_SOURCE0_RGB":                          true,
+	"GL_SOURCE1_ALPHA":                        true,
+	"GL_SOURCE1_RGB":                          true,
+	"GL_SOURCE2_ALPHA":                        true,
+	"GL_SOURCE2_RGB":                          true,
+	"GL_SPECULAR":                             true,
+	"GL_SPHERE_MAP":                           true,
+	"GL_SPOT_CUTOFF":                          true,
+	"GL_SPOT_DIRECTION":                       true,
+	"GL_SPOT_EXPONENT":                        true,
+	"GL_SRC0_ALPHA":                           true,
+	"GL_SRC0_RGB":                             true,
+	"GL_SRC1_RGB":                             true,
+	"GL_SRC2_ALPHA":                           true,
+	"GL_SRC2_RGB":                             true,
+	"GL_STENCIL_BITS":                         true,
+	"GL_SUBTRACT":                             true,
+	"GL_T":                                    true,
+	"GL_T2F_C3F_V3F":                          true,
+	"GL_T2F_C4F_N3F_V3F":                      true,
+	"GL_T2F_C4UB_V3F":                         true,
+	"GL_T2F_N3F_V3F":                          true,
+	"GL_T2F_V3F":                              true,
+	"GL_T4F_C4F_N3F_V4F":                      true,
+	"GL_T4F_V4F":                              true,
+	"GL_TEXTURE_BIT":                          true,
+	"GL_TEXTURE_BORDER":                       true,
+	"GL_TEXTURE_COMPONENTS":                   true,
+	"GL_TEXTURE_COORD_ARRAY":                  true,
+	"GL_TEXTURE_COORD_ARRAY_BUFFER_BINDING":   true,
+	"GL_TEXTURE_COORD_ARRAY_POINTER":          true,
+	"GL_TEXTURE_COORD_ARRAY_SIZE":             true,
+	"GL_TEXTURE_COORD_ARRAY_STRIDE":           true,
+	"GL_TEXTURE_COORD_ARRAY_TYPE":             true,
+	"GL_TEXTURE_ENV":                          true,
+	"GL_TEXTURE_ENV_COLOR":                    true,
+	"GL_TEXTURE_ENV_MODE":                     true,
+	"GL_TEXTURE_FILTER_CONTROL":               true,
+	"GL_TEXTURE_GEN_MODE":                     true,
+	"GL_TEXTURE_GEN_Q":                        true,
+	"GL_TEXTURE_GEN_R":                        true,
+	"GL_TEXTURE_GEN_S":                        true,
+	"GL_TEXTURE_GEN_T":                        true,
+	"GL_TEXTURE_INTENSITY_SIZE":               true,
+	"GL_TEXTURE_INTENSITY_TYPE":               true,
+	"GL_TEXTURE_LUMINANCE_SIZE":               true,
+	"GL_TEXTURE_LUMINANCE_TYPE":               true,
+	"GL_TEXTURE_MATRIX":                       true,
+	"GL_TEXTURE_PRIORITY":                     true,
+	"GL_TEXTURE_RESIDENT":                     true,
+	"GL_TEXTURE_STACK_DEPTH":                  true,
+	"GL_TRANSFORM_BIT":                        true,
+	"GL_TRANSPOSE_COLOR_MATRIX":               true,
+	"GL_TRANSPOSE_MODELVIEW_MATRIX":           true,
+	"GL_TRANSPOSE_PROJECTION_MATRIX":          true,
+	"GL_TRANSPOSE_TEXTURE_MATRIX":             true,
+	"GL_V2F":                                  true,
+	"GL_V3F":                                  true,
+	"GL_VERSION_ES_CL_1_0":                    true,
+	"GL_VERSION_ES_CL_1_1":                    true,
+	"GL_VERSION_ES_CM_1_1":                    true,
+	"GL_VERTEX_ARRAY_BUFFER_BINDING":          true,
+	"GL_VERTEX_ARRAY_POINTER":                 true,
+	"GL_VERTEX_ARRAY_SIZE":                    true,
+	"GL_VERTEX_ARRAY_STRIDE":                  true,
+	"GL_VERTEX_ARRAY_TYPE":                    true,
+	"GL_VERTEX_PROGRAM_TWO_SIDE":              true,
+	"GL_VIEWPORT_BIT":                         true,
+	"GL_WEIGHT_ARRAY_BUFFER_BINDING":          true,
+	"GL_ZOOM_X":                               true,
+	"GL_ZOOM_Y":                               true,
+	"glAccum":                                 true,
+	"glAlphaFunc":                             true,
+	"glAlphaFuncx":                            true,
+	"glAreTexturesResident":                   true,
+	"glArrayElement":                          true,
+	"glBegin":                                 true,
+	"glBitmap":                                true,
+	"glCallList":                              true,
+	"glCallLists":                             true,
+	"glClearAccum":                            true,
+	"glClearColorx":                           true,
+	"glClearDepthx":                           true,
+	"glClearIndex":                            true,
+	"glClientActiveTexture":                   true,
+	"glClipPlane":                             true,
+	"glClipPlanef":                            true,
+	"glClipPlanex":                            true,
+	"glColor3b":                               true,
+	"glColor3bv":                              true,
+	"glColor3d":                               true,
+	"glColor3dv":                              true,
+	"glColor3f":                               true,
+	"glColor3fv":                              true,
+	"glColor3i":                               true,
+	"glColor3iv":                              true,
+	"glColor3s":                               true,
+	"glColor3sv":                              true,
+	"glColor3ub":                              true,
+	"glColor3ubv":                             true,
+	"glColor3ui":                              true,
+	"glColor3uiv":                             true,
+	"glColor3us":                              true,
+	"glColor3usv":                             true,
+	"glColor4b":                               true,
+	"glColor4bv":                              true,
+	"glColor4d":                               true,
+	"glColor4dv":                              true,
+	"glColor4f":                               true,
+	"glColor4fv":                              true,
+	"glColor4i":                               true,
+	"glColor4iv":                              true,
+	"glColor4s":                               true,
+	"glColor4sv":                              true,
+	"glColor4ub":                              true,
+	"glColor4ubv":                             true,
+	"glColor4ui":                              true,
+	"glColor4uiv":                             true,
+	"glColor4us":                              true,
+	"glColor4usv":                             true,
+	"glColor4x":                               true,
+	"glColorMaterial":                         true,
+	"glColorP3ui":                             true,
+	"glColorP3uiv":                            true,
+	"glColorP4ui":                             true,
+	"glColorP4uiv":                            true,
+	"glColorPointer":                          true,
+	"glCopyPixels":                            true,
+	"glDeleteLists":                           true,
+	"glDepthRangex":                           true,
+	"glDisableClientState":                    true,
+	"glDrawPixels":                            true,
+	"glEdgeFlag":                              true,
+	"glEdgeFlagPointer":                       true,
+	"glEdgeFlagv":                             true,
+	"glEnableClientState":                     true,
+	"glEnd":                                   true,
+	"glEndList":                               true,
+	"glEvalCoord1d":                           true,
+	"glEvalCoord1dv":                          true,
+	"glEvalCoord1f":                           true,
+	"glEvalCoord1fv":                          true,
+	"glEvalCoord2d":                           true,
+	"glEvalCoord2dv":                          true,
+	"glEvalCoord2f":                           true,
+	"glEvalCoord2fv":                          true,
+	"glEvalMesh1":                             true,
+	"glEvalMesh2":                             true,
+	"glEvalPoint1":                            true,
+	"glEvalPoint2":                            true,
+	"glFeedbackBuffer":                        true,
+	"glFogCoordPointer":                       true,
+	"glFogCoordd":                             true,
+	"glFogCoorddv":                            true,
+	"glFogCoordf":                             true,
+	"glFogCoordfv":                            true,
+	"glFogf":                                  true,
+	"glFogfv":                                 true,
+	"glFogi":                                  true,
+	"glFogiv":                                 true,
+	"glFogx":                                  true,
+	"glFogxv":                                 true,
+	"glFrustum":                               true,
+	"glFrustumf":                              true,
+	"glFrustumx":                              true,
+	"glGenLists":                              true,
+	"glGetClipPlane":                          true,
+	"glGetClipPlanef":                         true,
+	"glGetClipPlanex":                         true,
+	"glGetFixedv":                             true,
+	"glGetLightfv":                            true,
+	"glGetLightiv":                            true,
+	"glGetLightxv":                            true,
+	"glGetMapdv":                              true,
+	"glGetMapfv":                              true,
+	"glGetMapiv":                              true,
+	"glGetMaterialfv":                         true,
+	"glGetMaterialiv":                         true,
+	"glGetMaterialxv":                         true,
+	"glGetPixelMapfv":                         true,
+	"glGetPixelMapuiv":                        true,
+	"glGetPixelMapusv":                        true,
+	"glGetPolygonStipple":                     true,
+	"glGetTexEnvfv":                           true,
+	"glGetTexEnviv":                           true,
+	"glGetTexEnvxv":                           true,
+	"glGetTexGendv":                           true,
+	"glGetTexGenfv":                           true,
+	"glGetTexGeniv":                           true,
+	"glGetTexParameterxv":                     true,
+	"glGetnColorTable":                        true,
+	"glGetnConvolutionFilter":                 true,
+	"glGetnHistogram":                         true,
+	"glGetnMapdv":                             true,
+	"glGetnMapfv":                             true,
+	"glGetnMapiv":                             true,
+	"glGetnMinmax":                            true,
+	"glGetnPixelMapfv":                        true,
+	"glGetnPixelMapuiv":                       true,
+	"glGetnPixelMapusv":                       true,
+	"glGetnPolygonStipple":                    true,
+	"glGetnSeparableFilter":                   true,
+	"glIndexMask":                             true,
+	"glIndexPointer":                          true,
+	"glIndexd":                                true,
+	"glIndexdv":                               true,
+	"glIndexf":                                true,
+	"glIndexfv":                               true,
+	"glIndexi":                                true,
+	"glIndexiv":                               true,
+	"glIndexs":                                true,
+	"glIndexsv":                               true,
+	"glIndexub":                               true,
+	"glIndexubv":                              true,
+	"glInitNames":                             true,
+	"glInterleavedArrays":                     true,
+	"glIsList":                                true,
+	"glLightModelf":                           true,
+	"glLightModelfv":                          true,
+	"glLightModeli":                           true,
+	"glLightModeliv":                          true,
+	"glLightModelx":                           true,
+	"glLightModelxv":                          true,
+	"glLightf":                                true,
+	"glLightfv":                               true,
+	"glLighti":                                true,
+	"glLightiv":                               true,
+	"glLightx":                                true,
+	"glLightxv":                               true,
+	"glLineStipple":                           true,
+	"glLineWidthx":                            true,
+	"glListBase":                              true,
+	"glLoadIdentity":                          true,
+	"glLoadMatrixd":                           true,
+	"glLoadMatrixf":                           true,
+	"glLoadMatrixx":                           true,
+	"glLoadName":                              true,
+	"glLoadTransposeMatrixd":                  true,
+	"glLoadTransposeMatrixf":                  true,
+	"glMap1d":                                 true,
+	"glMap1f":                                 true,
+	"glMap2d":                                 true,
+	"glMap2f":                                 true,
+	"glMapGrid1d":                             true,
+	"glMapGrid1f":                             true,
+	"glMapGrid2d":                             true,
+	"glMapGrid2f":                             true,
+	"glMaterialf":                             true,
+	"glMaterialfv":                            true,
+	"glMateriali":                             true,
+	"glMaterialiv":                            true,
+	"glMaterialx":                             true,
+	"glMaterialxv":                            true,
+	"glMatrixMode":                            true,
+	"glMultMatrixd":                           true,
+	"glMultMatrixf":                           true,
+	"glMultMatrixx":                           true,
+	"glMultTransposeMatrixd":                  true,
+	"glMultTransposeMatrixf":                  true,
+	"glMultiTexCoord1d":                       true,
+	"glMultiTexCoord1dv":                      true,
+	"glMultiTexCoord1f":                       true,
+	"glMultiTexCoord1fv":                      true,
+	"glMultiTexCoord1i":                       true,
+	"glMultiTexCoord1iv":                      true,
+	"glMultiTexCoord1s":                       true,
+	"glMultiTexCoord1sv":                      true,
+	"glMultiTexCoord2d":                       true,
+	"glMultiTexCoord2dv":                      true,
+	"glMultiTexCoord2f":                       true,
+	"glMultiTexCoord2fv":                      true,
+	"glMultiTexCoord2i":                       true,
+	"glMultiTexCoord2iv":                      true,
+	"glMultiTexCoord2s":                       true,
+	"glMultiTexCoord2sv":                      true,
+	"glMultiTexCoord3d":                       true,
+	"glMultiTexCoord3dv":                      true,
+	"glMultiTexCoord3f":                       true,
+	"glMultiTexCoord3fv":                      true,
+	"glMultiTexCoord3i":                       true,
+	"glMultiTexCoord3iv":                      true,
+	"glMultiTexCoord3s":                       true,
+	"glMultiTexCoord3sv":                      true,
+	"glMultiTexCoord4d":                       true,
+	"glMultiTexCoord4dv":                      true,
+	"glMultiTexCoord4f":                       true,
+	"glMultiTexCoord4fv":                      true,
+	"glMultiTexCoord4i":                       true,
+	"glMultiTexCoord4iv":                      true,
+	"glMultiTexCoord4s":                       true,
+	"glMultiTexCoord4sv":                      true,
+	"glMultiTexCoord4x":                       true,
+	"glMultiTexCoordP1ui":                     true,
+	"glMultiTexCoordP1uiv":                    true,
+	"glMultiTexCoordP2ui":                     true,
+	"glMultiTexCoordP2uiv":                    true,
+	"glMultiTexCoordP3ui":                     true,
+	"glMultiTexCoordP3uiv":                    true,
+	"glMultiTexCoordP4ui":                     true,
+	"glMultiTexCoordP4uiv":                    true,
+	"glNewList":                               true,
+	"glNormal3b":                              true,
+	"glNormal3bv":                             true,
+	"glNormal3d":                              true,
+	"glNormal3dv":                             true,
+	"glNormal3f":                              true,
+	"glNormal3fv":                             true,
+	"glNormal3i":                              true,
+	"glNormal3iv":                             true,
+	"glNormal3s":                              true,
+	"glNormal3sv":                             true,
+	"glNormal3x":                              true,
+	"glNormalP3ui":                            true,
+	"glNormalP3uiv":                           true,
+	"glNormalPointer":                         true,
+	"glOrtho":                                 true,
+	"glOrthof":                                true,
+	"glOrthox":                                true,
+	"glPassThrough":                           true,
+	"glPixelMapfv":                            true,
+	"glPixelMapuiv":                           true,
+	"glPixelMapusv":                           true,
+	"glPixelTransferf":                        true,
+	"glPixelTransferi":                        true,
+	"glPixelZoom":                             true,
+	"glPointParameterx":                       true,
+	"glPointParameterxv":                      true,
+	"glPointSizex":                            true,
+	"glPolygonOffsetx":                        true,
+	"glPolygonStipple":                        true,
+	"glPopAttrib":                             true,
+	"glPopClientAttrib":                       true,
+	"glPopMatrix":                             true,
+	"glPopName":                               true,
+	"glPrioritizeTextures":                    true,
+	"glPushAttrib":                            true,
+	"glPushClientAttrib":                      true,
+	"glPushMatrix":                            true,
+	"glPushName":                              true,
+	"glRasterPos2d":                           true,
+	"glRasterPos2dv":                          true,
+	"glRasterPos2f":                           true,
+	"glRasterPos2fv":                          true,
+	"glRasterPos2i":                           true,
+	"glRasterPos2iv":                          true,
+	"glRasterPos2s":                           true,
+	"glRasterPos2sv":                          true,
+	"glRasterPos3d":                           true,
+	"glRasterPos3dv":                          true,
+	"glRasterPos3f":                           true,
+	"glRasterPos3fv":                          true,
+	"glRasterPos3i":                           true,
+	"glRasterPos3iv":                          true,
+	"glRasterPos3s":                           true,
+	"glRasterPos3sv":                          true,
+	"glRasterPos4d":                           true,
+	"glRasterPos4dv":                          true,
+	"glRasterPos4f":                           true,
+	"glRasterPos4fv":                          true,
+	"glRasterPos4i":                           true,
+	"glRasterPos4iv":                          true,
+	"glRasterPos4s":                           true,
+	"glRasterPos4sv":                          true,
+	"glRectd":                                 true,
+	"glRectdv":                                true,
+	"glRectf":                                 true,
+	"glRectfv":                                true,
+	"glRecti":                                 true,
+	"glRectiv":                                true,
+	"glRects":                                 true,
+	"glRectsv":                                true,
+	"glRenderMode":                            true,
+	"glRotated":                               true,
+	"glRotatef":                               true,
+	"glRotatex":                               true,
+	"glSampleCoveragex":                       true,
+	"glScaled":                                true,
+	"glScalef":                                true,
+	"glScalex":                                true,
+	"glSecondaryColor3b":                      true,
+	"glSecondaryColor3bv":                     true,
+	"glSecondaryColor3d":                      true,
+	"glSecondaryColor3dv":                     true,
+	"glSecondaryColor3f":                      true,
+	"glSecondaryColor3fv":                     true,
+	"glSecondaryColor3i":                      true,
+	"glSecondaryColor3iv":                     true,
+	"glSecondaryColor3s":                      true,
+	"glSecondaryColor3sv":                     true,
+	"glSecondaryColor3ub":                     true,
+	"glSecondaryColor3ubv":                    true,
+	"glSecondaryColor3ui":                     true,
+	"glSecondaryColor3uiv":                    true,
+	"glSecondaryColor3us":                     true,
+	"glSecondaryColor3usv":                    true,
+	"glSecondaryColorP3ui":                    true,
+	"glSecondaryColorP3uiv":                   true,
+	"glSecondaryColorPointer":                 true,
+	"glSelectBuffer":                          true,
+	"glShadeModel":                            true,
+	"glTexCoord1d":                            true,
+	"glTexCoord1dv":                           true,
+	"glTexCoord1f":                            true,
+	"glTexCoord1fv":                           true,
+	"glTexCoord1i":                            true,
+	"glTexCoord1iv":                           true,
+	"glTexCoord1s":                            true,
+	"glTexCoord1sv":                           true,
+	"glTexCoord2d":                            true,
+	"glTexCoord2dv":                           true,
+	"glTexCoord2f":                            true,
+	"glTexCoord2fv":                           true,
+	"glTexCoord2i":                            true,
+	"glTexCoord2iv":                           true,
+	"glTexCoord2s":                            true,
+	"glTexCoord2sv":                           true,
+	"glTexCoord3d":                            true,
+	"glTexCoord3dv":                           true,
+	"glTexCoord3f":                            true,
+	"glTexCoord3fv":                           true,
+	"glTexCoord3i":                            true,
+	"glTexCoord3iv":                           true,
+	"glTexCoord3s":                            true,
+	"glTexCoord3sv":                           true,
+	"glTexCoord4d":                            true,
+	"glTexCoord4dv":                           true,
+	"glTexCoord4f":                            true,
+	"glTexCoord4fv":                           true,
+	"glTexCoord4i":                            true,
+	"glTexCoord4iv":                           true,
+	"glTexCoord4s":                            true,
+	"glTexCoord4sv":                           true,
+	"glTexCoordP1ui":                          true,
+	"glTexCoordP1uiv":                         true,
+	"glTexCoordP2ui":                          true,
+	"glTexCoordP2uiv":                         true,
+	"glTexCoordP3ui":                          true,
+	"glTexCoordP3uiv":                         true,
+	"glTexCoordP4ui":                          true,
+	"glTexCoordP4uiv":                         true,
+	"glTexCoordPointer":                       true,
+	"glTexEnvf":                               true,
+	"glTexEnvfv":                              true,
+	"glTexEnvi":                               true,
+	"glTexEnviv":                              true,
+	"glTexEnvx":                               true,
+	"glTexEnvxv":                              true,
+	"glTexGend":                               true,
+	"glTexGendv":                              true,
+	"glTexGenf":                               true,
+	"glTexGenfv":                              true,
+	"glTexGeni":                               true,
+	"glTexGeniv":                              true,
+	"glTexParameterx":                         true,
+	"glTexParameterxv":                        true,
+	"glTranslated":                            true,
+	"glTranslatef":                            true,
+	"glTranslatex":                            true,
+	"glVertex2d":                              true,
+	"glVertex2dv":                             true,
+	"glVertex2f":                              true,
+	"glVertex2fv":                             true,
+	"glVertex2i":                              true,
+	"glVertex2iv":                             true,
+	"glVertex2s":                              true,
+	"glVertex2sv":                             true,
+	"glVertex3d":                              true,
+	"glVertex3dv":                             true,
+	"glVertex3f":                              true,
+	"glVertex3fv":                             true,
+	"glVertex3i":                              true,
+	"glVertex3iv":                             true,
+	"glVertex3s":                              true,
+	"glVertex3sv":                             true,
+	"glVertex4d":                              true,
+	"glVertex4dv":                             true,
+	"glVertex4f":                              true,
+	"glVertex4fv":                             true,
+	"glVertex4i":                              true,
+	"glVertex4iv":                             true,
+	"glVertex4s":                              true,
+	"glVertex4sv":                             true,
+	"glVertexP2ui":                            true,
+	"glVertexP2uiv":                           true,
+	"glVertexP3ui":                            true,
+	"glVertexP3uiv":                           true,
+	"glVertexP4ui":                            true,
+	"glVertexP4uiv":                           true,
+	"glVertexPointer":                         true,
+	"glWindowPos2d":                           true,
+	"glWindowPos2dv":                          true,
+	"glWindowPos2f":                           true,
+	"glWindowPos2fv":                          true,
+	"glWindowPos2i":                           true,
+	"glWindowPos2iv":                          true,
+	"glWindowPos2s":                           true,
+	"glWindowPos2sv":                          true,
+	"glWindowPos3d":                           true,
+	"glWindowPos3dv":                          true,
+	"glWindowPos3f":                           true,
+	"glWindowPos3fv":                          true,
+	"glWindowPos3i":                           true,
+	"glWindowPos3iv":                          true,
+	"glWindowPos3s":                           true,
+	"glWindowPos3sv":                          true,
+}
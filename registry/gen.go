@@ -0,0 +1,393 @@
+//go:build ignore
+// +build ignore
+
+// Generates the symbol tables in this package from the Khronos XML registries vendored in xml/: gl.xml and glx.xml
+// from https://github.com/KhronosGroup/OpenGL-Registry, and egl.xml from https://github.com/KhronosGroup/EGL-Registry.
+// Run with `go generate ./registry`, optionally pointing -registry at another copy of them. Besides enum values, it
+// records the versions and extensions requiring each function and enum, from the <feature> and <extension> elements,
+// and the desktop GL functions and enums the core profile removes, from the <remove profile="core"> elements
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the registries read, in the order their features are recorded in
+var registries = []string{
+	"gl.xml",
+	"glx.xml",
+	"egl.xml",
+}
+
+// the APIs of the registries whose features are recorded; gl.xml also describes OpenGL SC, which apitrace doesn't trace
+var apis = map[string]bool{
+	"gl":    true,
+	"gles1": true,
+	"gles2": true,
+	"glx":   true,
+	"egl":   true,
+}
+
+type xmlRegistry struct {
+	Enums      []xmlEnums     `xml:"enums"`
+	Features   []xmlFeature   `xml:"feature"`
+	Extensions []xmlExtension `xml:"extensions>extension"`
+}
+
+type xmlEnums struct {
+	Enums []xmlEnum `xml:"enum"`
+}
+
+// api is only set on the enums whose value differs between APIs, e.g. GL_ACTIVE_PROGRAM_EXT
+type xmlEnum struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+	API   string `xml:"api,attr"`
+}
+
+type xmlFeature struct {
+	API      string         `xml:"api,attr"`
+	Name     string         `xml:"name,attr"`
+	Number   string         `xml:"number,attr"`
+	Requires []xmlInterface `xml:"require"`
+	Removes  []xmlInterface `xml:"remove"`
+}
+
+// supported is the APIs an extension is for, separated by |, e.g. gl|glcore|gles2, or disabled
+type xmlExtension struct {
+	Name      string         `xml:"name,attr"`
+	Supported string         `xml:"supported,attr"`
+	Requires  []xmlInterface `xml:"require"`
+}
+
+// The functions and enums a feature requires or removes, for one profile or API when they are set
+type xmlInterface struct {
+	Profile  string    `xml:"profile,attr"`
+	API      string    `xml:"api,attr"`
+	Commands []xmlName `xml:"command"`
+	Enums    []xmlName `xml:"enum"`
+}
+
+type xmlName struct {
+	Name string `xml:"name,attr"`
+}
+
+// The versions and extensions requiring each symbol, in the order the registries list them
+type features map[string][]string
+
+func (f features) add(symbol, feature string) {
+	for _, existing := range f[symbol] {
+		if existing == feature {
+			return
+		}
+	}
+
+	f[symbol] = append(f[symbol], feature)
+}
+
+func main() {
+	dir := flag.String("registry", "xml", "directory containing gl.xml, glx.xml and egl.xml")
+	output := flag.String("output", "enums.go", "file to write the enum table to")
+	featuresOutput := flag.String("features", "features.go", "file to write the version and extension tables to")
+	coreOutput := flag.String("core", "core.go", "file to write the symbols removed from the core profile to")
+	flag.Parse()
+
+	values := map[string]uint64{}
+	functionFeatures := features{}
+	enumFeatures := features{}
+
+	// the symbols of the core profile of the latest version of desktop GL
+	core := map[string]bool{}
+
+	for _, file := range registries {
+		r, err := readRegistry(filepath.Join(*dir, file))
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		addValues(r, values)
+		addFeatures(r, functionFeatures, enumFeatures)
+		addCore(r, core)
+	}
+
+	if err := writeEnums(*output, values); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeFeatures(*featuresOutput, functionFeatures, enumFeatures); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeRemoved(*coreOutput, core, functionFeatures, enumFeatures); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readRegistry(path string) (*xmlRegistry, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var r xmlRegistry
+
+	if err := xml.NewDecoder(file).Decode(&r); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+
+	return &r, nil
+}
+
+// Collect the value of every enum. Values given as expressions, such as EGL_CAST(EGLint,-1), are left out, and where
+// an enum's value differs between APIs the one shared by the rest wins
+func addValues(r *xmlRegistry, values map[string]uint64) {
+	for _, block := range r.Enums {
+		for _, e := range block.Enums {
+			value, err := strconv.ParseUint(e.Value, 0, 64)
+
+			if err != nil {
+				continue
+			}
+
+			if _, exists := values[e.Name]; !exists || len(e.API) == 0 {
+				values[e.Name] = value
+			}
+		}
+	}
+}
+
+// Record the version requiring each function and enum, in any profile, and the extensions requiring them
+func addFeatures(r *xmlRegistry, functionFeatures, enumFeatures features) {
+	for _, f := range r.Features {
+		if !apis[f.API] {
+			continue
+		}
+
+		for _, require := range f.Requires {
+			addInterface(require, f.Name, functionFeatures, enumFeatures)
+		}
+	}
+
+	for _, e := range r.Extensions {
+		if !supported(e.Supported) {
+			continue
+		}
+
+		for _, require := range e.Requires {
+			if len(require.API) > 0 && !apis[require.API] {
+				continue
+			}
+
+			addInterface(require, e.Name, functionFeatures, enumFeatures)
+		}
+	}
+}
+
+func addInterface(i xmlInterface, feature string, functionFeatures, enumFeatures features) {
+	for _, command := range i.Commands {
+		functionFeatures.add(command.Name, feature)
+	}
+
+	for _, e := range i.Enums {
+		enumFeatures.add(e.Name, feature)
+	}
+}
+
+func supported(apiList string) bool {
+	for _, api := range strings.Split(apiList, "|") {
+		// glcore is the core profile of gl
+		if apis[api] || api == "glcore" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Follow the versions of desktop GL in order, adding what each requires of the core profile and taking away what it
+// removes from it
+func addCore(r *xmlRegistry, core map[string]bool) {
+	var versions []xmlFeature
+
+	for _, f := range r.Features {
+		if f.API == "gl" {
+			versions = append(versions, f)
+		}
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versionBefore(versions[i].Number, versions[j].Number)
+	})
+
+	for _, f := range versions {
+		for _, require := range f.Requires {
+			if len(require.Profile) == 0 || require.Profile == "core" {
+				setInterface(core, require, true)
+			}
+		}
+
+		for _, remove := range f.Removes {
+			if len(remove.Profile) == 0 || remove.Profile == "core" {
+				setInterface(core, remove, false)
+			}
+		}
+	}
+}
+
+func setInterface(symbols map[string]bool, i xmlInterface, present bool) {
+	for _, command := range i.Commands {
+		symbols[command.Name] = present
+	}
+
+	for _, e := range i.Enums {
+		symbols[e.Name] = present
+	}
+}
+
+// compare version numbers such as 1.0 and 4.6 by their major then their minor version
+func versionBefore(a, b string) bool {
+	majorA, minorA := splitVersion(a)
+	majorB, minorB := splitVersion(b)
+
+	if majorA != majorB {
+		return majorA < majorB
+	}
+
+	return minorA < minorB
+}
+
+func splitVersion(number string) (int, int) {
+	parts := strings.SplitN(number, ".", 2)
+
+	major, _ := strconv.Atoi(parts[0])
+
+	if len(parts) < 2 {
+		return major, 0
+	}
+
+	minor, _ := strconv.Atoi(parts[1])
+
+	return major, minor
+}
+
+func writeEnums(path string, enums map[string]uint64) error {
+	names := make([]string, 0, len(enums))
+
+	for name := range enums {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+
+	b.WriteString("// Code generated by gen.go from the Khronos XML registries; DO NOT EDIT.\n\n")
+	b.WriteString("package registry\n\n")
+	b.WriteString("var enums = map[string]uint64{\n")
+
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t%q: 0x%X,\n", name, enums[name])
+	}
+
+	b.WriteString("}\n")
+
+	return writeSource(path, b.String())
+}
+
+func writeFeatures(path string, functionFeatures, enumFeatures features) error {
+	var b strings.Builder
+
+	b.WriteString("// Code generated by gen.go from the Khronos XML registries; DO NOT EDIT.\n\n")
+	b.WriteString("package registry\n\n")
+
+	writeFeatureTable(&b, "functionFeatures", functionFeatures)
+	b.WriteString("\n")
+	writeFeatureTable(&b, "enumFeatures", enumFeatures)
+
+	return writeSource(path, b.String())
+}
+
+// Write out the functions and enums a version of desktop GL requires that the core profile of the latest version
+// doesn't have
+func writeRemoved(path string, core map[string]bool, functionFeatures, enumFeatures features) error {
+	removed := []string{}
+
+	for _, f := range []features{functionFeatures, enumFeatures} {
+		for symbol, declared := range f {
+			if core[symbol] {
+				continue
+			}
+
+			for _, feature := range declared {
+				if strings.HasPrefix(feature, "GL_VERSION_") {
+					removed = append(removed, symbol)
+					break
+				}
+			}
+		}
+	}
+
+	sort.Strings(removed)
+
+	var b strings.Builder
+
+	b.WriteString("// Code generated by gen.go from the Khronos XML registries; DO NOT EDIT.\n\n")
+	b.WriteString("package registry\n\n")
+	b.WriteString("var removedFromCore = map[string]bool{\n")
+
+	for _, symbol := range removed {
+		fmt.Fprintf(&b, "\t%q: true,\n", symbol)
+	}
+
+	b.WriteString("}\n")
+
+	return writeSource(path, b.String())
+}
+
+func writeFeatureTable(b *strings.Builder, name string, f features) {
+	symbols := make([]string, 0, len(f))
+
+	for symbol := range f {
+		symbols = append(symbols, symbol)
+	}
+
+	sort.Strings(symbols)
+
+	fmt.Fprintf(b, "var %s = map[string][]string{\n", name)
+
+	for _, symbol := range symbols {
+		quoted := make([]string, len(f[symbol]))
+
+		for i, feature := range f[symbol] {
+			quoted[i] = strconv.Quote(feature)
+		}
+
+		fmt.Fprintf(b, "\t%q: {%s},\n", symbol, strings.Join(quoted, ", "))
+	}
+
+	b.WriteString("}\n")
+}
+
+func writeSource(path, source string) error {
+	formatted, err := format.Source([]byte(source))
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, formatted, 0644)
+}
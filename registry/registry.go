@@ -0,0 +1,122 @@
+// Package registry holds the GL, GLES, GLX and EGL symbols published by Khronos, so parsed calls can be resolved
+// without a GL implementation
+package registry
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run gen.go
+
+// Look up the numeric value of an enum or bitmask flag, e.g. GL_TEXTURE_2D is 0xDE1
+func EnumValue(name string) (uint64, bool) {
+	value, ok := enums[name]
+
+	return value, ok
+}
+
+// Whether a feature is a core version of an API, e.g. GL_VERSION_3_0 or GL_ES_VERSION_2_0, rather than an extension
+func IsVersion(feature string) bool {
+	return strings.Contains(feature, "_VERSION_")
+}
+
+// The extensions a function comes from, e.g. GL_ARB_bindless_texture for glGetTextureHandleARB. Functions that are part
+// of any core version are not attributed to an extension, even if one introduced them
+func FunctionExtensions(name string) []string {
+	return extensions(functionFeatures[name])
+}
+
+// The extensions an enum comes from, on the same terms as FunctionExtensions
+func EnumExtensions(name string) []string {
+	return extensions(enumFeatures[name])
+}
+
+// Every version and extension declaring a function
+func FunctionFeatures(name string) []string {
+	return functionFeatures[name]
+}
+
+// Every version and extension declaring an enum
+func EnumFeatures(name string) []string {
+	return enumFeatures[name]
+}
+
+// Whether a function or enum of desktop GL was left out of the core profile, as the fixed function pipeline and the
+// other symbols GL 3.0 deprecated were. Symbols of extensions and of other APIs are never removed
+func RemovedFromCore(name string) bool {
+	return removedFromCore[name]
+}
+
+// The APIs versions are given for. OpenGL ES 1.x is told apart from later versions, which are not compatible with it
+const (
+	GL    = "GL"
+	GLES1 = "GLES1"
+	GLES  = "GLES"
+	GLX   = "GLX"
+	EGL   = "EGL"
+)
+
+// A version of an API, e.g. GL 3.3
+type Version struct {
+	API   string
+	Major int
+	Minor int
+}
+
+var versionFeaturePattern = regexp.MustCompile(`^(GL|GLX|EGL)_(ES_)?VERSION_(ES_CM_)?(\d+)_(\d+)$`)
+
+// The version a feature stands for, e.g. GL 3.3 for GL_VERSION_3_3 and GLES 2.0 for GL_ES_VERSION_2_0
+func ParseVersion(feature string) (Version, bool) {
+	match := versionFeaturePattern.FindStringSubmatch(feature)
+
+	if match == nil {
+		return Version{}, false
+	}
+
+	api := match[1]
+
+	switch {
+	case len(match[3]) > 0:
+		api = GLES1
+	case len(match[2]) > 0:
+		api = GLES
+	}
+
+	major, _ := strconv.Atoi(match[4])
+	minor, _ := strconv.Atoi(match[5])
+
+	return Version{api, major, minor}, true
+}
+
+// Whether a version came before another of the same API
+func (v Version) Before(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+
+	return v.Minor < other.Minor
+}
+
+// The version as it is usually written, e.g. GL 3.3, with OpenGL ES 1.x written as GLES 1.1
+func (v Version) String() string {
+	api := v.API
+
+	if api == GLES1 {
+		api = GLES
+	}
+
+	return fmt.Sprintf("%s %d.%d", api, v.Major, v.Minor)
+}
+
+func extensions(features []string) []string {
+	for _, feature := range features {
+		if IsVersion(feature) {
+			return nil
+		}
+	}
+
+	return features
+}
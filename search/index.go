@@ -0,0 +1,220 @@
+// Package search builds and queries the per-trace call index: which calls use each function name, argument value,
+// named argument such as internalformat=GL_RGBA16F, and GL object such as texture:42
+package search
+
+import (
+	"encoding/json"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"sort"
+)
+
+// Terms are prefixed by what they describe, so one dictionary can hold them all
+const (
+	functionTerm = "f:"
+	valueTerm    = "v:"
+	argumentTerm = "a:"
+	objectTerm   = "o:"
+)
+
+// strings longer than this, typically shader sources, are only indexed by their start
+const maxTermLength = 256
+
+// An index is written in segments of roughly this many postings, so building one never holds a whole trace in memory
+const segmentSize = 250000
+
+// A call in the trace, as its frame and its position within the frame
+type Posting [2]int
+
+func (p Posting) Frame() int {
+	return p[0]
+}
+
+func (p Posting) Index() int {
+	return p[1]
+}
+
+// One part of a trace's index, covering a run of whole frames
+type Segment struct {
+	FirstFrame int                  `json:"firstFrame"`
+	LastFrame  int                  `json:"lastFrame"`
+	Terms      map[string][]Posting `json:"terms"`
+}
+
+// What is stored for the index as a whole
+type Header struct {
+	Segments int `json:"segments"`
+	Frames   int `json:"frames"`
+	Calls    int `json:"calls"`
+}
+
+// Builds a trace's index a frame at a time, handing each segment to store as it fills up
+type IndexWriter struct {
+	store    func(segment int, data []byte) error
+	current  *Segment
+	postings int
+	header   Header
+}
+
+func NewIndexWriter(store func(segment int, data []byte) error) *IndexWriter {
+	return &IndexWriter{store: store}
+}
+
+// Index every call in a frame
+func (w *IndexWriter) Add(frame *parsers.Frame) error {
+	if w.current == nil {
+		w.current = &Segment{FirstFrame: frame.ID, Terms: map[string][]Posting{}}
+	}
+
+	w.current.LastFrame = frame.ID
+
+	for i, call := range frame.Calls {
+		posting := Posting{frame.ID, i}
+
+		for _, term := range Terms(call) {
+			w.current.Terms[term] = append(w.current.Terms[term], posting)
+			w.postings++
+		}
+	}
+
+	w.header.Frames++
+	w.header.Calls += len(frame.Calls)
+
+	// segments only ever end between frames, so a call's postings are all in the same segment
+	if w.postings >= segmentSize {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// Store the last segment and return the header describing the whole index
+func (w *IndexWriter) Close() (*Header, error) {
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+
+	return &w.header, nil
+}
+
+func (w *IndexWriter) flush() error {
+	if w.current == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(w.current)
+
+	if err != nil {
+		return err
+	}
+
+	if err := w.store(w.header.Segments, data); err != nil {
+		return err
+	}
+
+	w.header.Segments++
+	w.current = nil
+	w.postings = 0
+
+	return nil
+}
+
+// The distinct terms a call is indexed under: its function, each of its argument values, each value along with the
+// name of the argument or struct member holding it, and the GL objects it names
+func Terms(call *parsers.Call) []string {
+	seen := map[string]bool{functionTerm + call.FunctionName: true}
+
+	for _, arg := range call.Args {
+		addValueTerms(seen, arg.Name, arg.Value)
+
+		if kind, ok := parsers.ObjectKind(call.FunctionName, arg.Name); ok {
+			addObjectTerms(seen, kind, arg.Value)
+		}
+	}
+
+	if call.Return != nil {
+		addValueTerms(seen, "return", call.Return)
+	}
+
+	terms := make([]string, 0, len(seen))
+
+	for term := range seen {
+		terms = append(terms, term)
+	}
+
+	sort.Strings(terms)
+
+	return terms
+}
+
+func addValueTerms(seen map[string]bool, name string, value *parsers.Value) {
+	if value == nil {
+		return
+	}
+
+	var texts []string
+
+	switch value.Kind {
+	case parsers.EnumValue:
+		texts = append(texts, value.Symbol)
+
+	case parsers.BitmaskValue:
+		texts = append(texts, value.Symbols...)
+
+	case parsers.StringValue:
+		if value.String != nil {
+			texts = append(texts, truncate(*value.String))
+		}
+
+	case parsers.IntValue, parsers.UintValue, parsers.FloatValue, parsers.PointerValue, parsers.BoolValue:
+		texts = append(texts, value.Text)
+
+	case parsers.ArrayValue:
+		for _, element := range value.Elements {
+			addValueTerms(seen, name, element)
+		}
+
+	case parsers.StructValue:
+		for _, member := range value.Members {
+			addValueTerms(seen, member.Name, member.Value)
+		}
+	}
+
+	for _, text := range texts {
+		if len(text) == 0 {
+			continue
+		}
+
+		seen[valueTerm+text] = true
+
+		if len(name) > 0 {
+			seen[argumentTerm+name+"="+text] = true
+		}
+	}
+}
+
+func addObjectTerms(seen map[string]bool, kind string, value *parsers.Value) {
+	if value == nil {
+		return
+	}
+
+	switch value.Kind {
+	case parsers.IntValue, parsers.UintValue:
+		// zero unbinds rather than naming an object
+		if value.Text != "0" {
+			seen[objectTerm+kind+":"+value.Text] = true
+		}
+
+	case parsers.ArrayValue:
+		for _, element := range value.Elements {
+			addObjectTerms(seen, kind, element)
+		}
+	}
+}
+
+func truncate(s string) string {
+	if len(s) > maxTermLength {
+		return s[:maxTermLength]
+	}
+
+	return s
+}
@@ -0,0 +1,198 @@
+package search
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	ExactMatch  = "exact"
+	PrefixMatch = "prefix"
+	RegexMatch  = "regex"
+)
+
+// A search is a list of clauses separated by spaces, all of which a call has to match. A clause is either a bare
+// value, matched against function names and argument values; name=value, matched against the values of arguments and
+// struct members with that name; or kind:name, matched against the GL objects a call names, such as texture:42.
+// Double quotes keep spaces inside a clause
+type Query struct {
+	Clauses []*Clause
+}
+
+type Clause struct {
+	Name   string
+	Value  string
+	Object bool
+	exact  bool
+	regex  *regexp.Regexp
+}
+
+func ParseQuery(q, match string) (*Query, error) {
+	switch match {
+	case "":
+		match = ExactMatch
+	case ExactMatch, PrefixMatch, RegexMatch:
+	default:
+		return nil, fmt.Errorf("unknown match <%s>, expected one of %s, %s or %s", match, ExactMatch, PrefixMatch, RegexMatch)
+	}
+
+	query := new(Query)
+
+	for _, text := range splitClauses(q) {
+		clause := &Clause{Value: text, exact: match == ExactMatch}
+
+		if i := strings.Index(text, "="); i > 0 {
+			clause.Name = text[:i]
+			clause.Value = text[i+1:]
+		} else if i := strings.Index(text, ":"); i > 0 && parsers.IsObjectKind(text[:i]) {
+			clause.Name = text[:i]
+			clause.Value = text[i+1:]
+			clause.Object = true
+		}
+
+		pattern := "^" + regexp.QuoteMeta(clause.Value) + "$"
+
+		switch match {
+		case PrefixMatch:
+			pattern = "^" + regexp.QuoteMeta(clause.Value)
+		case RegexMatch:
+			pattern = clause.Value
+		}
+
+		var err error
+
+		clause.regex, err = regexp.Compile(pattern)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression <%s>: %s", clause.Value, err.Error())
+		}
+
+		query.Clauses = append(query.Clauses, clause)
+	}
+
+	if len(query.Clauses) == 0 {
+		return nil, fmt.Errorf("empty search")
+	}
+
+	return query, nil
+}
+
+func splitClauses(q string) []string {
+	var clauses []string
+	var current strings.Builder
+
+	quoted := false
+
+	for _, r := range q {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ' ' && !quoted:
+			if current.Len() > 0 {
+				clauses = append(clauses, current.String())
+				current.Reset()
+			}
+			continue
+		}
+
+		if r != '"' {
+			current.WriteRune(r)
+		}
+	}
+
+	if current.Len() > 0 {
+		clauses = append(clauses, current.String())
+	}
+
+	return clauses
+}
+
+// the terms an exact clause can match, which can be looked up directly rather than compared against every term
+func (clause *Clause) exactTerms() []string {
+	if clause.Object {
+		return []string{objectTerm + clause.Name + ":" + clause.Value}
+	}
+
+	if len(clause.Name) > 0 {
+		return []string{argumentTerm + clause.Name + "=" + clause.Value}
+	}
+
+	return []string{functionTerm + clause.Value, valueTerm + clause.Value}
+}
+
+// whether an indexed term satisfies the clause
+func (clause *Clause) matches(term string) bool {
+	if clause.Object {
+		prefix := objectTerm + clause.Name + ":"
+
+		return strings.HasPrefix(term, prefix) && clause.regex.MatchString(term[len(prefix):])
+	}
+
+	if len(clause.Name) > 0 {
+		prefix := argumentTerm + clause.Name + "="
+
+		return strings.HasPrefix(term, prefix) && clause.regex.MatchString(term[len(prefix):])
+	}
+
+	for _, kind := range []string{functionTerm, valueTerm} {
+		if strings.HasPrefix(term, kind) && clause.regex.MatchString(term[len(kind):]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The calls in the segment matching every clause of the query, in trace order
+func (segment *Segment) Search(query *Query) []Posting {
+	var result map[Posting]bool
+
+	for _, clause := range query.Clauses {
+		matched := map[Posting]bool{}
+
+		add := func(postings []Posting) {
+			for _, posting := range postings {
+				if result == nil || result[posting] {
+					matched[posting] = true
+				}
+			}
+		}
+
+		if clause.exact {
+			for _, term := range clause.exactTerms() {
+				add(segment.Terms[term])
+			}
+		} else {
+			for term, postings := range segment.Terms {
+				if clause.matches(term) {
+					add(postings)
+				}
+			}
+		}
+
+		result = matched
+
+		if len(result) == 0 {
+			return nil
+		}
+	}
+
+	hits := make([]Posting, 0, len(result))
+
+	for posting := range result {
+		hits = append(hits, posting)
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Frame() != hits[j].Frame() {
+			return hits[i].Frame() < hits[j].Frame()
+		}
+
+		return hits[i].Index() < hits[j].Index()
+	})
+
+	return hits
+}
@@ -0,0 +1,168 @@
+package search
+
+import (
+	"encoding/json"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const dump = `0 glGenTextures(n = 2, textures = {1, 2})
+1 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+2 glTexImage2D(target = GL_TEXTURE_2D, level = 0, internalformat = GL_RGBA16F, width = 256, height = 256, border = 0, format = GL_RGBA, type = GL_FLOAT, pixels = NULL)
+3 glBindTexture(target = GL_TEXTURE_2D, texture = 0)
+4 glClear(mask = GL_COLOR_BUFFER_BIT | GL_DEPTH_BUFFER_BIT)
+5 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+6 glBindTexture(target = GL_TEXTURE_2D, texture = 2)
+7 glShaderSource(shader = 3, count = 1, string = &"#version 330 core", length = NULL)
+8 glDrawElementsIndirect(mode = GL_TRIANGLES, type = GL_UNSIGNED_INT, indirect = &{count = 36, instanceCount = 1, firstIndex = 0, baseVertex = 0, baseInstance = 0})
+9 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+`
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		match   string
+		clauses []Clause
+		err     string
+	}{
+		{
+			query:   "glBindTexture",
+			clauses: []Clause{{Value: "glBindTexture"}},
+		},
+		{
+			query: `internalformat=GL_RGBA16F  texture:1 "string=#version 330"`,
+			match: PrefixMatch,
+			clauses: []Clause{
+				{Name: "internalformat", Value: "GL_RGBA16F"},
+				{Name: "texture", Value: "1", Object: true},
+				{Name: "string", Value: "#version 330"},
+			},
+		},
+		{
+			// only the kinds of GL object are taken for objects
+			query:   "target:2",
+			clauses: []Clause{{Value: "target:2"}},
+		},
+		{query: "  ", err: "empty search"},
+		{query: "glClear", match: "fuzzy", err: "unknown match <fuzzy>"},
+		{query: "gl(Clear", match: RegexMatch, err: "invalid regular expression <gl(Clear>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, err := ParseQuery(test.query, test.match)
+
+			if len(test.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("expected the error <%s>, got %v", test.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			clauses := []Clause{}
+
+			for _, clause := range query.Clauses {
+				clauses = append(clauses, Clause{Name: clause.Name, Value: clause.Value, Object: clause.Object})
+			}
+
+			if !reflect.DeepEqual(clauses, test.clauses) {
+				t.Errorf("expected the clauses %+v, got %+v", test.clauses, clauses)
+			}
+		})
+	}
+}
+
+func TestSegmentSearch(t *testing.T) {
+	segment := index(t, dump)
+
+	tests := []struct {
+		query string
+		match string
+		hits  []Posting
+	}{
+		{query: "glBindTexture", hits: []Posting{{0, 1}, {0, 3}, {1, 0}}},
+		{query: "GL_TEXTURE_2D", hits: []Posting{{0, 1}, {0, 2}, {0, 3}, {1, 0}}},
+		{query: "internalformat=GL_RGBA16F", hits: []Posting{{0, 2}}},
+		{query: "format=GL_RGBA16F"},
+
+		// objects, named alone or in an array, but not zero, which unbinds
+		{query: "texture:1", hits: []Posting{{0, 0}, {0, 1}}},
+		{query: "texture:0"},
+		{query: "glBindTexture texture:2", hits: []Posting{{1, 0}}},
+		{query: "glClear GL_RGBA"},
+
+		// bitmask flags, struct members and strings
+		{query: "GL_DEPTH_BUFFER_BIT", hits: []Posting{{0, 4}}},
+		{query: "count=36", hits: []Posting{{1, 2}}},
+		{query: "count=1", hits: []Posting{{1, 1}}},
+		{query: `"string=#version 330 core"`, hits: []Posting{{1, 1}}},
+
+		{query: "glBind", match: PrefixMatch, hits: []Posting{{0, 1}, {0, 3}, {1, 0}}},
+		{query: "GL_DEPTH", match: PrefixMatch, hits: []Posting{{0, 4}}},
+		{query: `"string=#version"`, match: PrefixMatch, hits: []Posting{{1, 1}}},
+		{query: "texture:", match: PrefixMatch, hits: []Posting{{0, 0}, {0, 1}, {1, 0}}},
+		{query: "^gl(Gen|Delete)Textures$", match: RegexMatch, hits: []Posting{{0, 0}}},
+		{query: "width=^2[0-9]+$ format=RGBA$", match: RegexMatch, hits: []Posting{{0, 2}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.match+" "+test.query, func(t *testing.T) {
+			query, err := ParseQuery(test.query, test.match)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hits := segment.Search(query)
+
+			if len(hits) == 0 && len(test.hits) == 0 {
+				return
+			}
+
+			if !reflect.DeepEqual(hits, test.hits) {
+				t.Errorf("expected the calls %v, got %v", test.hits, hits)
+			}
+		})
+	}
+}
+
+// index a dump and read back the segment written for it, as it is read when searching
+func index(t *testing.T, dump string) *Segment {
+	var stored [][]byte
+
+	w := NewIndexWriter(func(segment int, data []byte) error {
+		stored = append(stored, data)
+		return nil
+	})
+
+	for _, frame := range parsers.ParseDump(dump).Frames {
+		if err := w.Add(frame); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	header, err := w.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *header != (Header{Segments: 1, Frames: 2, Calls: 10}) || len(stored) != 1 {
+		t.Fatalf("expected one segment of 2 frames and 10 calls, got %+v", *header)
+	}
+
+	segment := new(Segment)
+
+	if err := json.Unmarshal(stored[0], segment); err != nil {
+		t.Fatal(err)
+	}
+
+	return segment
+}
@@ -0,0 +1,144 @@
+package search
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"github.com/fergloragain/apitrace-remote/registry"
+	"sort"
+)
+
+// How many calls a trace makes to each function, and to each extension through its functions and enums
+type Usage struct {
+	Functions  map[string]int
+	Extensions map[string]int
+}
+
+func NewUsage() *Usage {
+	return &Usage{Functions: map[string]int{}, Extensions: map[string]int{}}
+}
+
+// Count a call against its function and every extension it relies on
+func (u *Usage) Add(call *parsers.Call) {
+	u.Functions[call.FunctionName]++
+
+	extensions := map[string]bool{}
+
+	for _, extension := range registry.FunctionExtensions(call.FunctionName) {
+		extensions[extension] = true
+	}
+
+	for _, arg := range call.Args {
+		addEnumExtensions(extensions, arg.Value)
+	}
+
+	for extension := range extensions {
+		u.Extensions[extension]++
+	}
+}
+
+func addEnumExtensions(extensions map[string]bool, value *parsers.Value) {
+	if value == nil {
+		return
+	}
+
+	var symbols []string
+
+	switch value.Kind {
+	case parsers.EnumValue:
+		symbols = []string{value.Symbol}
+
+	case parsers.BitmaskValue:
+		symbols = value.Symbols
+
+	case parsers.ArrayValue:
+		for _, element := range value.Elements {
+			addEnumExtensions(extensions, element)
+		}
+
+	case parsers.StructValue:
+		for _, member := range value.Members {
+			addEnumExtensions(extensions, member.Value)
+		}
+	}
+
+	for _, symbol := range symbols {
+		for _, extension := range registry.EnumExtensions(symbol) {
+			extensions[extension] = true
+		}
+	}
+}
+
+// What is stored for one function or extension across every trace: the number of calls each trace makes to it
+type UsageRecord struct {
+	Name   string                 `json:"name"`
+	Traces map[string]*TraceCalls `json:"traces"`
+}
+
+type TraceCalls struct {
+	App   string `json:"app"`
+	Calls int    `json:"calls"`
+}
+
+// A function or extension's usage, grouped by app, busiest first
+type UsageReport struct {
+	Name       string      `json:"name"`
+	Extensions []string    `json:"extensions,omitempty"`
+	Calls      int         `json:"calls"`
+	Apps       []*AppUsage `json:"apps"`
+}
+
+type AppUsage struct {
+	App    string        `json:"app"`
+	Calls  int           `json:"calls"`
+	Traces []*TraceUsage `json:"traces"`
+}
+
+type TraceUsage struct {
+	Trace string `json:"trace"`
+	Calls int    `json:"calls"`
+}
+
+func NewUsageRecord(name string) *UsageRecord {
+	return &UsageRecord{Name: name, Traces: map[string]*TraceCalls{}}
+}
+
+// Group a record's traces by app
+func (record *UsageRecord) Report() *UsageReport {
+	report := &UsageReport{Name: record.Name, Apps: []*AppUsage{}}
+
+	apps := map[string]*AppUsage{}
+
+	for trace, calls := range record.Traces {
+		app, ok := apps[calls.App]
+
+		if !ok {
+			app = &AppUsage{App: calls.App}
+			apps[calls.App] = app
+			report.Apps = append(report.Apps, app)
+		}
+
+		app.Calls += calls.Calls
+		app.Traces = append(app.Traces, &TraceUsage{trace, calls.Calls})
+
+		report.Calls += calls.Calls
+	}
+
+	for _, app := range report.Apps {
+		sort.Slice(app.Traces, func(i, j int) bool {
+			if app.Traces[i].Calls != app.Traces[j].Calls {
+				return app.Traces[i].Calls > app.Traces[j].Calls
+			}
+
+			return app.Traces[i].Trace < app.Traces[j].Trace
+		})
+	}
+
+	sort.Slice(report.Apps, func(i, j int) bool {
+		if report.Apps[i].Calls != report.Apps[j].Calls {
+			return report.Apps[i].Calls > report.Apps[j].Calls
+		}
+
+		return report.Apps[i].App < report.Apps[j].App
+	})
+
+	return report
+}
@@ -0,0 +1,346 @@
+// Package shaders extracts the shaders and programs a trace creates, so they can be listed, compared and validated
+// without replaying it
+package shaders
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"sort"
+	"strings"
+)
+
+// A distinct shader source in a trace, however many shader objects were given it
+type Shader struct {
+	Hash  string `json:"hash"`
+	Stage string `json:"stage"`
+	Lines int    `json:"lines"`
+
+	// the shader objects given this source, and the call and frame that first did so
+	Shaders    []string `json:"shaders"`
+	Call       string   `json:"call"`
+	FirstFrame int      `json:"firstFrame"`
+
+	// the programs linked with it, and the first frame one of them was used in
+	Programs  []string `json:"programs"`
+	FirstUsed *int     `json:"firstUsed,omitempty"`
+
+	// left out of a trace's catalogue, as sources are stored once by hash for every trace using them
+	Source string `json:"source,omitempty"`
+}
+
+// A shader source as stored by its hash, with the traces it appears in
+type Source struct {
+	Hash   string   `json:"hash"`
+	Stage  string   `json:"stage"`
+	Source string   `json:"source"`
+	Traces []string `json:"traces"`
+}
+
+// A link of a program, with the hashes of the shaders attached at the time. A program linked more than once has an
+// entry for each link
+type Program struct {
+	Program   string   `json:"program"`
+	Call      string   `json:"call"`
+	Frame     int      `json:"frame"`
+	Separable bool     `json:"separable,omitempty"`
+	Shaders   []string `json:"shaders"`
+	FirstUsed *int     `json:"firstUsed,omitempty"`
+}
+
+type Catalogue struct {
+	Shaders  []*Shader  `json:"shaders"`
+	Programs []*Program `json:"programs"`
+}
+
+// Follows the shader and program calls of a trace a frame at a time. Shader and program names are assumed to be shared
+// by every context in the trace
+type CatalogueBuilder struct {
+	shaders  map[string]*Shader
+	order    []string
+	stages   map[string]string
+	sources  map[string]string
+	attached map[string]map[string]bool
+	linked   map[string]*Program
+	programs []*Program
+}
+
+func NewCatalogueBuilder() *CatalogueBuilder {
+	return &CatalogueBuilder{
+		shaders:  map[string]*Shader{},
+		stages:   map[string]string{},
+		sources:  map[string]string{},
+		attached: map[string]map[string]bool{},
+		linked:   map[string]*Program{},
+	}
+}
+
+// Pick up the shader and program calls of a frame
+func (b *CatalogueBuilder) Add(frame *parsers.Frame) {
+	for _, call := range frame.Calls {
+		b.add(frame.ID, call)
+	}
+}
+
+func (b *CatalogueBuilder) add(frame int, call *parsers.Call) {
+	switch strings.TrimSuffix(call.FunctionName, "ARB") {
+	case "glCreateShader", "glCreateShaderObject":
+		if call.Return != nil && len(call.Args) > 0 {
+			b.stages[call.Return.Text] = Stage(call.Args[0].Value)
+		}
+
+	case "glShaderSource":
+		if len(call.Args) < 4 {
+			return
+		}
+
+		shader := call.Args[0].Value.Text
+		source := joinSource(call.Args[2].Value, call.Args[3].Value)
+
+		b.sources[shader] = b.record(source, b.stages[shader], shader, call.ID, frame).Hash
+
+	case "glCreateShaderProgramv", "glCreateShaderProgramEXT":
+		if call.Return == nil || len(call.Args) == 0 {
+			return
+		}
+
+		var source string
+
+		if len(call.Args) >= 3 {
+			source = joinSource(call.Args[2].Value, nil)
+		} else if len(call.Args) == 2 {
+			// glCreateShaderProgramEXT takes a single string
+			source = joinSource(call.Args[1].Value, nil)
+		}
+
+		shader := b.record(source, Stage(call.Args[0].Value), "", call.ID, frame)
+
+		b.link(&Program{
+			Program:   call.Return.Text,
+			Call:      call.ID,
+			Frame:     frame,
+			Separable: true,
+			Shaders:   []string{shader.Hash},
+		})
+
+	case "glAttachShader", "glAttachObject":
+		if len(call.Args) >= 2 {
+			program, shader := call.Args[0].Value.Text, call.Args[1].Value.Text
+
+			if b.attached[program] == nil {
+				b.attached[program] = map[string]bool{}
+			}
+
+			b.attached[program][shader] = true
+		}
+
+	case "glDetachShader", "glDetachObject":
+		if len(call.Args) >= 2 {
+			delete(b.attached[call.Args[0].Value.Text], call.Args[1].Value.Text)
+		}
+
+	case "glLinkProgram":
+		if len(call.Args) == 0 {
+			return
+		}
+
+		program := &Program{
+			Program: call.Args[0].Value.Text,
+			Call:    call.ID,
+			Frame:   frame,
+			Shaders: []string{},
+		}
+
+		for shader := range b.attached[program.Program] {
+			if hash, ok := b.sources[shader]; ok && !contains(program.Shaders, hash) {
+				program.Shaders = append(program.Shaders, hash)
+			}
+		}
+
+		sort.Strings(program.Shaders)
+
+		b.link(program)
+
+	case "glUseProgram", "glUseProgramObject":
+		if len(call.Args) > 0 {
+			b.use(call.Args[0].Value.Text, frame)
+		}
+
+	case "glUseProgramStages":
+		if len(call.Args) >= 3 {
+			b.use(call.Args[2].Value.Text, frame)
+		}
+	}
+}
+
+// add a source to the catalogue, or another shader object to the entry already holding it
+func (b *CatalogueBuilder) record(source, stage, shader, call string, frame int) *Shader {
+	hash := Hash(source)
+
+	entry, ok := b.shaders[hash]
+
+	if !ok {
+		entry = &Shader{
+			Hash:       hash,
+			Stage:      stage,
+			Lines:      lines(source),
+			Shaders:    []string{},
+			Call:       call,
+			FirstFrame: frame,
+			Programs:   []string{},
+			Source:     source,
+		}
+
+		b.shaders[hash] = entry
+		b.order = append(b.order, hash)
+	}
+
+	if len(shader) > 0 && !contains(entry.Shaders, shader) {
+		entry.Shaders = append(entry.Shaders, shader)
+	}
+
+	return entry
+}
+
+func (b *CatalogueBuilder) link(program *Program) {
+	b.linked[program.Program] = program
+	b.programs = append(b.programs, program)
+
+	for _, hash := range program.Shaders {
+		shader := b.shaders[hash]
+
+		if !contains(shader.Programs, program.Program) {
+			shader.Programs = append(shader.Programs, program.Program)
+		}
+	}
+}
+
+func (b *CatalogueBuilder) use(name string, frame int) {
+	program, ok := b.linked[name]
+
+	if !ok || program.FirstUsed != nil {
+		return
+	}
+
+	program.FirstUsed = &frame
+
+	for _, hash := range program.Shaders {
+		shader := b.shaders[hash]
+
+		if shader.FirstUsed == nil || *shader.FirstUsed > frame {
+			shader.FirstUsed = &frame
+		}
+	}
+}
+
+// The shaders in the order their sources were first given, and every program link in trace order
+func (b *CatalogueBuilder) Catalogue() *Catalogue {
+	catalogue := &Catalogue{Shaders: []*Shader{}, Programs: b.programs}
+
+	for _, hash := range b.order {
+		catalogue.Shaders = append(catalogue.Shaders, b.shaders[hash])
+	}
+
+	if catalogue.Programs == nil {
+		catalogue.Programs = []*Program{}
+	}
+
+	return catalogue
+}
+
+// The content hash a shader source is catalogued under
+func Hash(source string) string {
+	sum := sha256.Sum256([]byte(source))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Whether an ID is a content hash, as opposed to the ID of a trace's catalogue
+func IsHash(id string) bool {
+	if len(id) != sha256.Size*2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(id)
+
+	return err == nil
+}
+
+// Note that a trace uses a source
+func (source *Source) AddTrace(traceID string) {
+	if !contains(source.Traces, traceID) {
+		source.Traces = append(source.Traces, traceID)
+	}
+}
+
+// The stage of a shader, e.g. vertex for GL_VERTEX_SHADER
+func Stage(shaderType *parsers.Value) string {
+	if shaderType == nil {
+		return ""
+	}
+
+	symbol := shaderType.Symbol
+
+	if len(symbol) == 0 {
+		symbol = shaderType.Text
+	}
+
+	symbol = strings.TrimSuffix(strings.TrimSuffix(symbol, "_ARB"), "_EXT")
+
+	return strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(symbol, "GL_"), "_SHADER"))
+}
+
+// the strings given to glShaderSource, each cut to its length when one was given
+func joinSource(strs, lengths *parsers.Value) string {
+	var parts []*parsers.Value
+
+	if strs == nil {
+		return ""
+	}
+
+	if strs.Kind == parsers.ArrayValue {
+		parts = strs.Elements
+	} else {
+		parts = []*parsers.Value{strs}
+	}
+
+	var source strings.Builder
+
+	for i, part := range parts {
+		var s string
+
+		if part.String != nil {
+			s = *part.String
+		}
+
+		if lengths != nil && lengths.Kind == parsers.ArrayValue && i < len(lengths.Elements) {
+			if length, ok := lengths.Elements[i].Integer(); ok && length >= 0 && int(length) < len(s) {
+				s = s[:length]
+			}
+		}
+
+		source.WriteString(s)
+	}
+
+	return source.String()
+}
+
+func lines(source string) int {
+	n := strings.Count(source, "\n")
+
+	if len(source) > 0 && !strings.HasSuffix(source, "\n") {
+		n++
+	}
+
+	return n
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,238 @@
+package shaders
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCatalogueBuilder(t *testing.T) {
+	tests := []struct {
+		name string
+		dump string
+
+		// each shader as #its index, stage, lines, shader objects, first call and frame, programs and first use,
+		// and each program link likewise, with its shaders by index
+		shaders  []string
+		programs []string
+	}{
+		{
+			// shader objects given the same source share an entry, whichever programs they are linked into
+			name: "identical sources across programs",
+			dump: `0 glCreateShader(type = GL_VERTEX_SHADER) = 1
+1 glShaderSource(shader = 1, count = 1, string = &"void main() {}\n", length = NULL)
+2 glCreateShader(type = GL_VERTEX_SHADER) = 2
+3 glShaderSource(shader = 2, count = 1, string = &"void main() {}\n", length = NULL)
+4 glCreateShader(type = GL_FRAGMENT_SHADER) = 3
+5 glShaderSource(shader = 3, count = 1, string = &"out vec4 colour;\nvoid main() {}\n", length = NULL)
+6 glCreateProgram() = 4
+7 glAttachShader(program = 4, shader = 1)
+8 glAttachShader(program = 4, shader = 3)
+9 glLinkProgram(program = 4)
+10 glCreateProgram() = 5
+11 glAttachShader(program = 5, shader = 2)
+12 glAttachShader(program = 5, shader = 3)
+13 glLinkProgram(program = 5)
+14 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+15 glUseProgram(program = 5)`,
+			shaders: []string{
+				"#0 vertex 1 lines [1 2] at 1 in frame 0 programs [4 5] used in frame 1",
+				"#1 fragment 2 lines [3] at 5 in frame 0 programs [4 5] used in frame 1",
+			},
+			programs: []string{
+				"4 at 9 in frame 0 [#0 #1] unused",
+				"5 at 13 in frame 0 [#0 #1] used in frame 1",
+			},
+		},
+		{
+			// each link is an entry of its own, and only the latest link of a program is marked used
+			name: "relinks",
+			dump: `0 glCreateShader(type = GL_VERTEX_SHADER) = 1
+1 glShaderSource(shader = 1, count = 1, string = &"void main() {}\n", length = NULL)
+2 glCreateShader(type = GL_FRAGMENT_SHADER) = 2
+3 glShaderSource(shader = 2, count = 1, string = &"out vec4 colour;\nvoid main() {}\n", length = NULL)
+4 glCreateProgram() = 3
+5 glAttachShader(program = 3, shader = 1)
+6 glAttachShader(program = 3, shader = 2)
+7 glLinkProgram(program = 3)
+8 glUseProgram(program = 3)
+9 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+10 glShaderSource(shader = 2, count = 1, string = &"out vec4 colour;\nvoid main() { colour = vec4(1.0); }\n", length = NULL)
+11 glLinkProgram(program = 3)
+12 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+13 glUseProgram(program = 3)`,
+			shaders: []string{
+				"#0 vertex 1 lines [1] at 1 in frame 0 programs [3] used in frame 0",
+				"#1 fragment 2 lines [2] at 3 in frame 0 programs [3] used in frame 0",
+				"#2 fragment 2 lines [2] at 10 in frame 1 programs [3] used in frame 2",
+			},
+			programs: []string{
+				"3 at 7 in frame 0 [#0 #1] used in frame 0",
+				"3 at 11 in frame 1 [#0 #2] used in frame 2",
+			},
+		},
+		{
+			name: "detached shaders",
+			dump: `0 glCreateShader(type = GL_VERTEX_SHADER) = 1
+1 glShaderSource(shader = 1, count = 1, string = &"void main() {}\n", length = NULL)
+2 glCreateShader(type = GL_FRAGMENT_SHADER) = 2
+3 glShaderSource(shader = 2, count = 1, string = &"out vec4 colour;\nvoid main() {}\n", length = NULL)
+4 glCreateProgram() = 3
+5 glAttachShader(program = 3, shader = 1)
+6 glAttachShader(program = 3, shader = 2)
+7 glDetachShader(program = 3, shader = 2)
+8 glLinkProgram(program = 3)
+9 glUseProgram(program = 3)`,
+			shaders: []string{
+				"#0 vertex 1 lines [1] at 1 in frame 0 programs [3] used in frame 0",
+				"#1 fragment 2 lines [2] at 3 in frame 0 programs [] unused",
+			},
+			programs: []string{
+				"3 at 8 in frame 0 [#0] used in frame 0",
+			},
+		},
+		{
+			// a source split across strings, or with lengths cutting them, hashes the same as in one string
+			name: "source arrays",
+			dump: `0 glCreateShader(type = GL_FRAGMENT_SHADER) = 1
+1 glShaderSource(shader = 1, count = 1, string = &"#version 330\nvoid main() {}\n", length = NULL)
+2 glCreateShader(type = GL_FRAGMENT_SHADER) = 2
+3 glShaderSource(shader = 2, count = 2, string = {"#version 330\n", "void main() {}\n"}, length = NULL)
+4 glCreateShader(type = GL_FRAGMENT_SHADER) = 3
+5 glShaderSource(shader = 3, count = 2, string = {"#version 330\n// cut", "void main() {}\nunterminated"}, length = {13, 15})
+6 glCreateShader(type = GL_FRAGMENT_SHADER) = 4
+7 glShaderSource(shader = 4, count = 2, string = {"#version 330\n", "void main() {}\n"}, length = {-1, -1})
+8 glCreateShader(type = GL_FRAGMENT_SHADER) = 5
+9 glShaderSource(shader = 5, count = 2, string = {"#version 330\n", "void main() {}"}, length = NULL)`,
+			shaders: []string{
+				"#0 fragment 2 lines [1 2 3 4] at 1 in frame 0 programs [] unused",
+				"#1 fragment 2 lines [5] at 9 in frame 0 programs [] unused",
+			},
+		},
+		{
+			// programs created from a single source are linked there and then, and used through pipelines
+			name: "separable programs",
+			dump: `0 glCreateShaderProgramv(type = GL_FRAGMENT_SHADER, count = 1, strings = &"out vec4 colour;\nvoid main() {}\n") = 5
+1 glCreateShaderProgramv(type = GL_VERTEX_SHADER, count = 2, strings = {"#version 410\n", "void main() {}\n"}) = 6
+2 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+3 glUseProgramStages(pipeline = 1, stages = GL_FRAGMENT_SHADER_BIT, program = 5)`,
+			shaders: []string{
+				"#0 fragment 2 lines [] at 0 in frame 0 programs [5] used in frame 1",
+				"#1 vertex 2 lines [] at 1 in frame 0 programs [6] unused",
+			},
+			programs: []string{
+				"5 separable at 0 in frame 0 [#0] used in frame 1",
+				"6 separable at 1 in frame 0 [#1] unused",
+			},
+		},
+		{
+			name: "ARB shader objects",
+			dump: `0 glCreateShaderObjectARB(shaderType = GL_VERTEX_SHADER_ARB) = 1
+1 glShaderSourceARB(shaderObj = 1, count = 1, string = &"void main() {}\n", length = NULL)
+2 glCreateProgramObjectARB() = 2
+3 glAttachObjectARB(containerObj = 2, obj = 1)
+4 glLinkProgramARB(programObj = 2)
+5 glUseProgramObjectARB(programObj = 2)`,
+			shaders: []string{
+				"#0 vertex 1 lines [1] at 1 in frame 0 programs [2] used in frame 0",
+			},
+			programs: []string{
+				"2 at 4 in frame 0 [#0] used in frame 0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			builder := NewCatalogueBuilder()
+
+			for _, frame := range parsers.ParseDump(test.dump).Frames {
+				builder.Add(frame)
+			}
+
+			catalogue := builder.Catalogue()
+
+			indices := map[string]string{}
+			shaders := []string{}
+
+			for i, shader := range catalogue.Shaders {
+				indices[shader.Hash] = fmt.Sprintf("#%d", i)
+
+				if shader.Hash != Hash(shader.Source) {
+					t.Errorf("expected shader %d to be catalogued under the hash of its source, got %s", i, shader.Hash)
+				}
+
+				shaders = append(shaders, fmt.Sprintf("#%d %s %d lines %v at %s in frame %d programs %v %s", i,
+					shader.Stage, shader.Lines, shader.Shaders, shader.Call, shader.FirstFrame, shader.Programs,
+					describeUse(shader.FirstUsed)))
+			}
+
+			programs := []string{}
+
+			for _, program := range catalogue.Programs {
+				refs := []string{}
+
+				for _, hash := range program.Shaders {
+					refs = append(refs, indices[hash])
+				}
+
+				sort.Strings(refs)
+
+				name := program.Program
+
+				if program.Separable {
+					name += " separable"
+				}
+
+				programs = append(programs, fmt.Sprintf("%s at %s in frame %d [%s] %s", name, program.Call,
+					program.Frame, strings.Join(refs, " "), describeUse(program.FirstUsed)))
+			}
+
+			if !reflect.DeepEqual(shaders, nonNil(test.shaders)) {
+				t.Errorf("expected the shaders\n%s\ngot\n%s", strings.Join(test.shaders, "\n"), strings.Join(shaders, "\n"))
+			}
+
+			if !reflect.DeepEqual(programs, nonNil(test.programs)) {
+				t.Errorf("expected the programs\n%s\ngot\n%s", strings.Join(test.programs, "\n"),
+					strings.Join(programs, "\n"))
+			}
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		id     string
+		isHash bool
+	}{
+		// hashes are SHA-256 in hex, so they stay the same across runs and servers
+		{Hash("abc"), true},
+		{"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", true},
+		{"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015a", false},
+		{"zz7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", false},
+		{"app-trace-1", false},
+	}
+
+	if hash := Hash("abc"); hash != tests[1].id {
+		t.Errorf("expected the hash %s, got %s", tests[1].id, hash)
+	}
+
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			if IsHash(test.id) != test.isHash {
+				t.Errorf("expected IsHash to be %t, got %t", test.isHash, !test.isHash)
+			}
+		})
+	}
+}
+
+func describeUse(frame *int) string {
+	if frame == nil {
+		return "unused"
+	}
+
+	return fmt.Sprintf("used in frame %d", *frame)
+}
@@ -0,0 +1,241 @@
+package shaders
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/diff"
+	"sort"
+)
+
+// The shaders added, removed and modified between two traces. Shaders with the same source in both are only counted
+type Comparison struct {
+	Added     []*Change `json:"added"`
+	Removed   []*Change `json:"removed"`
+	Modified  []*Change `json:"modified"`
+	Unchanged int       `json:"unchanged"`
+}
+
+// A shader as it was in the first trace, the second, or both, with a unified diff between the two sources
+type Change struct {
+	Stage  string  `json:"stage"`
+	Before *Shader `json:"before,omitempty"`
+	After  *Shader `json:"after,omitempty"`
+	Diff   string  `json:"diff"`
+}
+
+// Compare the shaders of two traces. Shaders whose hash appears in both are unchanged. The rest are paired up through
+// the programs they were linked into: a program in the second trace sharing shaders with one in the first, or failing
+// that with the same name and stages, is the same program, and its shaders that differ at the same stage were
+// modified. Whatever is left was added or removed. source reads a shader's source by its hash
+func Compare(nameA, nameB string, a, b *Catalogue, source func(hash string) (string, error)) (*Comparison, error) {
+	comparison := &Comparison{Added: []*Change{}, Removed: []*Change{}, Modified: []*Change{}}
+
+	shadersA, shadersB := byHash(a), byHash(b)
+
+	for hash := range shadersA {
+		if _, ok := shadersB[hash]; ok {
+			comparison.Unchanged++
+		}
+	}
+
+	onlyA := func(hash string) bool { _, inB := shadersB[hash]; return !inB }
+	onlyB := func(hash string) bool { _, inA := shadersA[hash]; return !inA }
+
+	paired := map[string]string{}
+	pairedB := map[string]bool{}
+
+	for _, pair := range pairPrograms(a, b, shadersA, shadersB) {
+		stagesA, stagesB := stages(pair[0], shadersA), stages(pair[1], shadersB)
+
+		for stage, hashA := range stagesA {
+			hashB, ok := stagesB[stage]
+
+			if !ok || hashA == hashB || !onlyA(hashA) || !onlyB(hashB) {
+				continue
+			}
+
+			if _, done := paired[hashA]; done || pairedB[hashB] {
+				continue
+			}
+
+			paired[hashA] = hashB
+			pairedB[hashB] = true
+		}
+	}
+
+	changed := func(before, after *Shader) (*Change, error) {
+		change := &Change{Before: before, After: after}
+
+		var textA, textB string
+		var err error
+
+		labelA, labelB := "/dev/null", "/dev/null"
+
+		if before != nil {
+			change.Stage = before.Stage
+			labelA = fmt.Sprintf("%s/%s.%s", nameA, before.Hash[:12], before.Stage)
+
+			if textA, err = source(before.Hash); err != nil {
+				return nil, err
+			}
+		}
+
+		if after != nil {
+			change.Stage = after.Stage
+			labelB = fmt.Sprintf("%s/%s.%s", nameB, after.Hash[:12], after.Stage)
+
+			if textB, err = source(after.Hash); err != nil {
+				return nil, err
+			}
+		}
+
+		change.Diff = diff.Unified(labelA, labelB, textA, textB)
+
+		return change, nil
+	}
+
+	for _, shader := range a.Shaders {
+		var change *Change
+		var err error
+
+		switch {
+		case !onlyA(shader.Hash):
+			continue
+		case len(paired[shader.Hash]) > 0:
+			change, err = changed(shader, shadersB[paired[shader.Hash]])
+			comparison.Modified = append(comparison.Modified, change)
+		default:
+			change, err = changed(shader, nil)
+			comparison.Removed = append(comparison.Removed, change)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, shader := range b.Shaders {
+		if !onlyB(shader.Hash) || pairedB[shader.Hash] {
+			continue
+		}
+
+		change, err := changed(nil, shader)
+
+		if err != nil {
+			return nil, err
+		}
+
+		comparison.Added = append(comparison.Added, change)
+	}
+
+	return comparison, nil
+}
+
+func byHash(catalogue *Catalogue) map[string]*Shader {
+	shaders := map[string]*Shader{}
+
+	for _, shader := range catalogue.Shaders {
+		shaders[shader.Hash] = shader
+	}
+
+	return shaders
+}
+
+// the shader a program was linked with at each stage
+func stages(program *Program, shaders map[string]*Shader) map[string]string {
+	stages := map[string]string{}
+
+	for _, hash := range program.Shaders {
+		if shader, ok := shaders[hash]; ok {
+			stages[shader.Stage] = hash
+		}
+	}
+
+	return stages
+}
+
+// Pair each program of the first trace with the program of the second sharing the most shaders with it, or the one
+// with the same name and stages when none share any. Programs already identical in both need no pairing
+func pairPrograms(a, b *Catalogue, shadersA, shadersB map[string]*Shader) [][2]*Program {
+	var pairs [][2]*Program
+
+	used := map[*Program]bool{}
+
+	for _, programA := range a.Programs {
+		var best *Program
+		bestShared := 0
+
+		stagesA := stages(programA, shadersA)
+
+		for _, programB := range b.Programs {
+			if used[programB] {
+				continue
+			}
+
+			stagesB := stages(programB, shadersB)
+
+			if !sameStages(stagesA, stagesB) {
+				continue
+			}
+
+			shared := 0
+
+			for stage, hash := range stagesA {
+				if stagesB[stage] == hash {
+					shared++
+				}
+			}
+
+			if shared == len(stagesA) {
+				// identical, and so nothing to pair
+				best = nil
+				used[programB] = true
+				break
+			}
+
+			if shared > bestShared || (shared == bestShared && best == nil && programB.Program == programA.Program) {
+				best = programB
+				bestShared = shared
+			}
+		}
+
+		if best != nil {
+			used[best] = true
+			pairs = append(pairs, [2]*Program{programA, best})
+		}
+	}
+
+	// programs sharing the most shaders are the surest pairs, so they are tried first
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return sharedShaders(pairs[i]) > sharedShaders(pairs[j])
+	})
+
+	return pairs
+}
+
+func sameStages(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for stage := range a {
+		if _, ok := b[stage]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sharedShaders(pair [2]*Program) int {
+	shared := 0
+
+	for _, hashA := range pair[0].Shaders {
+		for _, hashB := range pair[1].Shaders {
+			if hashA == hashB {
+				shared++
+			}
+		}
+	}
+
+	return shared
+}
@@ -0,0 +1,292 @@
+package shaders
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A variable declared at global scope, or a member of a block
+type Declaration struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Array  string `json:"array,omitempty"`
+	Layout string `json:"layout,omitempty"`
+}
+
+// An interface block, e.g. `layout(std140) uniform Lights { vec4 colour[4]; } lights;`
+type Block struct {
+	Name     string         `json:"name"`
+	Storage  string         `json:"storage"`
+	Instance string         `json:"instance,omitempty"`
+	Layout   string         `json:"layout,omitempty"`
+	Members  []*Declaration `json:"members"`
+}
+
+// The interface a shader declares. Attributes are the inputs of vertex shaders, varyings are passed between stages,
+// and outputs are what fragment shaders write
+type Reflection struct {
+	Version    int            `json:"version"`
+	Profile    string         `json:"profile"`
+	Uniforms   []*Declaration `json:"uniforms"`
+	Attributes []*Declaration `json:"attributes"`
+	Varyings   []*Declaration `json:"varyings"`
+	Outputs    []*Declaration `json:"outputs"`
+	Blocks     []*Block       `json:"blocks"`
+}
+
+var (
+	versionPattern = regexp.MustCompile(`(?m)^[ \t]*#[ \t]*version[ \t]+(\d+)(?:[ \t]+(\w+))?`)
+	commentPattern = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+	tokenPattern   = regexp.MustCompile(`[A-Za-z_]\w*|\d[\w.]*|\S`)
+)
+
+var storageQualifiers = map[string]bool{
+	"uniform": true, "in": true, "out": true, "attribute": true, "varying": true, "buffer": true,
+}
+
+// qualifiers that say nothing about which list a declaration belongs in
+var otherQualifiers = map[string]bool{
+	"const": true, "flat": true, "smooth": true, "noperspective": true, "centroid": true, "sample": true,
+	"patch": true, "invariant": true, "precise": true, "highp": true, "mediump": true, "lowp": true,
+	"coherent": true, "volatile": true, "restrict": true, "readonly": true, "writeonly": true, "shared": true,
+}
+
+// Read the version, profile and global declarations of a shader from its source. This only looks at declarations,
+// so it works on shaders that fail to compile
+func Reflect(stage, source string) *Reflection {
+	reflection := &Reflection{
+		Version:    110,
+		Uniforms:   []*Declaration{},
+		Attributes: []*Declaration{},
+		Varyings:   []*Declaration{},
+		Outputs:    []*Declaration{},
+		Blocks:     []*Block{},
+	}
+
+	if match := versionPattern.FindStringSubmatch(source); match != nil {
+		reflection.Version, _ = strconv.Atoi(match[1])
+		reflection.Profile = match[2]
+	}
+
+	if len(reflection.Profile) == 0 {
+		switch {
+		case reflection.Version == 100:
+			reflection.Profile = "es"
+		case reflection.Version >= 150:
+			reflection.Profile = "core"
+		default:
+			reflection.Profile = "compatibility"
+		}
+	}
+
+	tokens := tokenise(source)
+
+	for i := 0; i < len(tokens); {
+		i = reflection.statement(stage, tokens, i)
+	}
+
+	return reflection
+}
+
+// strip comments and preprocessor lines, then split what is left into identifiers, numbers and punctuation
+func tokenise(source string) []string {
+	source = commentPattern.ReplaceAllString(source, " ")
+
+	var lines []string
+
+	for _, line := range strings.Split(source, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "#") {
+			lines = append(lines, line)
+		}
+	}
+
+	return tokenPattern.FindAllString(strings.Join(lines, "\n"), -1)
+}
+
+// read one global statement starting at tokens[i], returning where the next one starts
+func (reflection *Reflection) statement(stage string, tokens []string, i int) int {
+	var layout, storage string
+	var rest []string
+
+	for ; i < len(tokens); i++ {
+		token := tokens[i]
+
+		switch {
+		case token == ";":
+			reflection.declare(stage, storage, layout, rest)
+			return i + 1
+
+		case token == "{":
+			if len(storage) > 0 && len(rest) == 1 {
+				return reflection.block(storage, layout, rest[0], tokens, i+1)
+			}
+
+			// function bodies and struct definitions
+			return skipBraces(tokens, i+1)
+
+		case token == "layout" && i+1 < len(tokens) && tokens[i+1] == "(":
+			layout, i = layoutQualifiers(tokens, i)
+
+		case storageQualifiers[token] && len(rest) == 0:
+			storage = token
+
+		case otherQualifiers[token] && len(rest) == 0:
+
+		default:
+			rest = append(rest, token)
+		}
+	}
+
+	return i
+}
+
+// read the qualifiers of the layout at tokens[i], e.g. `std140, binding=1`, returning them and where they end
+func layoutQualifiers(tokens []string, i int) (string, int) {
+	end := i + 2
+
+	for end < len(tokens) && tokens[end] != ")" {
+		end++
+	}
+
+	layout := strings.Join(tokens[i+2:end], " ")
+
+	return strings.NewReplacer(" ,", ",", " = ", "=").Replace(layout), end
+}
+
+// read the members of an interface block and the instance name after it
+func (reflection *Reflection) block(storage, layout, name string, tokens []string, i int) int {
+	block := &Block{Name: name, Storage: storage, Layout: layout, Members: []*Declaration{}}
+
+	var memberLayout string
+	var member []string
+
+	for ; i < len(tokens) && tokens[i] != "}"; i++ {
+		switch {
+		case tokens[i] == ";":
+			block.Members = append(block.Members, declarations(memberLayout, member)...)
+			memberLayout, member = "", nil
+		case otherQualifiers[tokens[i]] && len(member) == 0:
+		case tokens[i] == "layout" && i+1 < len(tokens) && tokens[i+1] == "(":
+			memberLayout, i = layoutQualifiers(tokens, i)
+		default:
+			member = append(member, tokens[i])
+		}
+	}
+
+	for i++; i < len(tokens) && tokens[i] != ";"; i++ {
+		if len(block.Instance) == 0 && isIdentifier(tokens[i]) {
+			block.Instance = tokens[i]
+		}
+	}
+
+	reflection.Blocks = append(reflection.Blocks, block)
+
+	return i + 1
+}
+
+func (reflection *Reflection) declare(stage, storage, layout string, tokens []string) {
+	if len(storage) == 0 {
+		return
+	}
+
+	declared := declarations(layout, tokens)
+
+	switch {
+	case storage == "uniform":
+		reflection.Uniforms = append(reflection.Uniforms, declared...)
+	case storage == "attribute", storage == "in" && stage == "vertex":
+		reflection.Attributes = append(reflection.Attributes, declared...)
+	case storage == "out" && stage == "fragment":
+		reflection.Outputs = append(reflection.Outputs, declared...)
+	case storage == "varying", storage == "in", storage == "out":
+		reflection.Varyings = append(reflection.Varyings, declared...)
+	}
+}
+
+// split `vec4 a, b[2] = ...` into a declaration for each name. A bare layout statement such as
+// `layout(local_size_x = 8) in;` declares nothing
+func declarations(layout string, tokens []string) []*Declaration {
+	if len(tokens) < 2 {
+		return nil
+	}
+
+	var declared []*Declaration
+
+	typeName := tokens[0]
+	i := 1
+
+	// arrays of the type itself, e.g. float[4] weights
+	for i < len(tokens) && tokens[i] == "[" {
+		end := closing(tokens, i, "[", "]")
+		typeName += strings.Join(tokens[i:end+1], "")
+		i = end + 1
+	}
+
+	for i < len(tokens) {
+		if !isIdentifier(tokens[i]) {
+			i++
+			continue
+		}
+
+		declaration := &Declaration{Name: tokens[i], Type: typeName, Layout: layout}
+		i++
+
+		for i < len(tokens) && tokens[i] == "[" {
+			end := closing(tokens, i, "[", "]")
+			declaration.Array += strings.Join(tokens[i:end+1], "")
+			i = end + 1
+		}
+
+		// skip an initialiser up to the next name
+		for depth := 0; i < len(tokens) && (depth > 0 || tokens[i] != ","); i++ {
+			switch tokens[i] {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				depth--
+			}
+		}
+
+		declared = append(declared, declaration)
+		i++
+	}
+
+	return declared
+}
+
+func closing(tokens []string, i int, open, close string) int {
+	depth := 0
+
+	for ; i < len(tokens); i++ {
+		switch tokens[i] {
+		case open:
+			depth++
+		case close:
+			depth--
+
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return len(tokens) - 1
+}
+
+func skipBraces(tokens []string, i int) int {
+	for depth := 1; i < len(tokens) && depth > 0; i++ {
+		switch tokens[i] {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		}
+	}
+
+	return i
+}
+
+func isIdentifier(token string) bool {
+	return len(token) > 0 && (token[0] == '_' || (token[0] >= 'A' && token[0] <= 'Z') || (token[0] >= 'a' && token[0] <= 'z'))
+}
@@ -0,0 +1,261 @@
+package shaders
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestReflect(t *testing.T) {
+	tests := []struct {
+		name   string
+		stage  string
+		source string
+
+		// the version and profile, e.g. 330 core
+		version string
+
+		// each declaration as its layout, type, name and array, by the list it is in
+		uniforms   []string
+		attributes []string
+		varyings   []string
+		outputs    []string
+
+		// each block as its layout, storage, name and instance, then its members
+		blocks []string
+	}{
+		{
+			name:  "vertex shader",
+			stage: "vertex",
+			source: `#version 330 core
+layout(location = 0) in vec3 position;
+layout(location = 1) in vec2 uv;
+
+uniform mat4 model, view, projection;
+
+out vec2 texCoord;
+flat out int instance;
+
+void main() {
+    texCoord = uv;
+    instance = gl_InstanceID;
+    gl_Position = projection * view * model * vec4(position, 1.0);
+}
+`,
+			version:    "330 core",
+			uniforms:   []string{"mat4 model", "mat4 view", "mat4 projection"},
+			attributes: []string{"(location=0) vec3 position", "(location=1) vec2 uv"},
+			varyings:   []string{"vec2 texCoord", "int instance"},
+		},
+		{
+			name:  "fragment shader",
+			stage: "fragment",
+			source: `#version 300 es
+precision mediump float;
+
+in vec2 texCoord;
+
+uniform highp sampler2D diffuse, normalMap;
+uniform vec3 tint = vec3(1.0, 0.5, 0.25), fog;
+
+layout(location = 0) out vec4 colour;
+
+void main() {
+    colour = texture(diffuse, texCoord) * vec4(tint, 1.0);
+}
+`,
+			version:  "300 es",
+			uniforms: []string{"sampler2D diffuse", "sampler2D normalMap", "vec3 tint", "vec3 fog"},
+			varyings: []string{"vec2 texCoord"},
+			outputs:  []string{"(location=0) vec4 colour"},
+		},
+		{
+			// GLSL 1.10 when there is no version
+			name:  "legacy shaders",
+			stage: "vertex",
+			source: `attribute vec4 vertex;
+varying vec4 colour;
+uniform float weights[4], scale;
+uniform float[2] offsets;
+`,
+			version:    "110 compatibility",
+			uniforms:   []string{"float weights[4]", "float scale", "float[2] offsets"},
+			attributes: []string{"vec4 vertex"},
+			varyings:   []string{"vec4 colour"},
+		},
+		{
+			name:     "GLSL ES 1.00",
+			stage:    "fragment",
+			source:   "#version 100\nvarying lowp vec4 colour;\nvoid main() { gl_FragColor = colour; }\n",
+			version:  "100 es",
+			varyings: []string{"vec4 colour"},
+		},
+		{
+			name:     "core profile by default from GLSL 1.50",
+			stage:    "geometry",
+			source:   "  #  version 150\nuniform float size;\n",
+			version:  "150 core",
+			uniforms: []string{"float size"},
+		},
+		{
+			// declarations in comments are left out, and both sides of preprocessor conditionals are read
+			name:  "comments and the preprocessor",
+			stage: "fragment",
+			source: `#version 450
+// uniform float commented;
+/* uniform float
+   alsoCommented; */
+#define LIGHTS 4
+#ifdef SHADOWS
+uniform sampler2DShadow shadowMap;
+#else
+uniform sampler2D shadowMap;
+#endif
+uniform vec4 lights[LIGHTS];
+`,
+			version:  "450 core",
+			uniforms: []string{"sampler2DShadow shadowMap", "sampler2D shadowMap", "vec4 lights[LIGHTS]"},
+		},
+		{
+			// struct definitions and function bodies declare nothing, though they may use the storage qualifiers
+			name:  "structs and functions",
+			stage: "fragment",
+			source: `#version 330
+struct Light {
+    vec3 position;
+    vec4 colour;
+};
+
+uniform Light lights[2][3];
+
+vec3 shade(in Light light, out float attenuation);
+
+vec3 shade(in Light light, out float attenuation) {
+    attenuation = 1.0;
+    return light.colour.rgb;
+}
+
+const float gamma = 2.2;
+`,
+			version:  "330 core",
+			uniforms: []string{"Light lights[2][3]"},
+		},
+		{
+			name:  "uniform blocks",
+			stage: "vertex",
+			source: `#version 420
+layout(std140) uniform;
+
+layout(std140, binding = 1) uniform Lights {
+    vec4 colour[4];
+    layout(row_major) mat4 transforms[2], normals;
+    highp float count;
+} lights;
+
+uniform Matrices {
+    mat4 mvp;
+};
+
+out Vertex {
+    vec3 normal;
+    flat int id;
+} vertices[3];
+`,
+			version: "420 core",
+			blocks: []string{
+				"(std140, binding=1) uniform Lights lights: vec4 colour[4], (row_major) mat4 transforms[2], " +
+					"(row_major) mat4 normals, float count",
+				"uniform Matrices: mat4 mvp",
+				"out Vertex vertices: vec3 normal, int id",
+			},
+		},
+		{
+			name:  "compute shader",
+			stage: "compute",
+			source: `#version 430
+layout(local_size_x = 8, local_size_y = 8) in;
+
+layout(std430, binding = 0) buffer Particles {
+    vec4 positions[];
+};
+
+layout(binding = 1, rgba8) uniform writeonly image2D target;
+
+void main() {}
+`,
+			version:  "430 core",
+			uniforms: []string{"(binding=1, rgba8) image2D target"},
+			blocks:   []string{"(std430, binding=0) buffer Particles: vec4 positions[]"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reflection := Reflect(test.stage, test.source)
+
+			if version := strconv.Itoa(reflection.Version) + " " + reflection.Profile; version != test.version {
+				t.Errorf("expected the version %s, got %s", test.version, version)
+			}
+
+			for _, list := range []struct {
+				name         string
+				declarations []*Declaration
+				want         []string
+			}{
+				{"uniforms", reflection.Uniforms, test.uniforms},
+				{"attributes", reflection.Attributes, test.attributes},
+				{"varyings", reflection.Varyings, test.varyings},
+				{"outputs", reflection.Outputs, test.outputs},
+			} {
+				if got := describeDeclarations(list.declarations); !reflect.DeepEqual(got, nonNil(list.want)) {
+					t.Errorf("expected the %s %v, got %v", list.name, list.want, got)
+				}
+			}
+
+			blocks := []string{}
+
+			for _, block := range reflection.Blocks {
+				description := block.Storage + " " + block.Name
+
+				if len(block.Layout) > 0 {
+					description = "(" + block.Layout + ") " + description
+				}
+
+				if len(block.Instance) > 0 {
+					description += " " + block.Instance
+				}
+
+				blocks = append(blocks, description+": "+strings.Join(describeDeclarations(block.Members), ", "))
+			}
+
+			if !reflect.DeepEqual(blocks, nonNil(test.blocks)) {
+				t.Errorf("expected the blocks\n%s\ngot\n%s", strings.Join(test.blocks, "\n"), strings.Join(blocks, "\n"))
+			}
+		})
+	}
+}
+
+func describeDeclarations(declarations []*Declaration) []string {
+	described := []string{}
+
+	for _, declaration := range declarations {
+		description := declaration.Type + " " + declaration.Name + declaration.Array
+
+		if len(declaration.Layout) > 0 {
+			description = "(" + declaration.Layout + ") " + description
+		}
+
+		described = append(described, description)
+	}
+
+	return described
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,179 @@
+package shaders
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A shader checked offline: what the validator said about it, and the interface it declares
+type Validation struct {
+	Hash  string `json:"hash"`
+	Stage string `json:"stage"`
+
+	// whether the validator ran; when it could not, Error says why and only the reflection is filled in
+	Validated   bool          `json:"validated"`
+	Error       string        `json:"error,omitempty"`
+	Valid       bool          `json:"valid"`
+	Diagnostics []*Diagnostic `json:"diagnostics"`
+
+	*Reflection
+}
+
+// An error or warning from the validator. Line is 0 for problems found when linking
+type Diagnostic struct {
+	Severity string `json:"severity"`
+	Line     int    `json:"line,omitempty"`
+	Message  string `json:"message"`
+}
+
+// the form glslangValidator writes diagnostics in, e.g. `ERROR: 0:12: 'foo' : undeclared identifier`, where the source
+// string number is the file name in later versions, e.g. `ERROR: /tmp/shader123/shader.frag:12: ...`
+var diagnosticPattern = regexp.MustCompile(`^(ERROR|WARNING): (?:(\S+):(\d+): )?(.*)$`)
+
+// The file extension glslangValidator works out a shader's stage from
+func StageExtension(stage string) string {
+	switch stage {
+	case "vertex":
+		return "vert"
+	case "tess_control":
+		return "tesc"
+	case "tess_evaluation":
+		return "tese"
+	case "geometry":
+		return "geom"
+	case "fragment":
+		return "frag"
+	case "compute":
+		return "comp"
+	}
+
+	return ""
+}
+
+// Read the diagnostics out of glslangValidator's output, leaving out its summaries of how many errors there were
+func ParseDiagnostics(output string) []*Diagnostic {
+	diagnostics := []*Diagnostic{}
+
+	for _, line := range strings.Split(output, "\n") {
+		match := diagnosticPattern.FindStringSubmatch(strings.TrimSpace(line))
+
+		if match == nil || strings.HasSuffix(match[4], "compilation errors.  No code generated.") {
+			continue
+		}
+
+		diagnostic := &Diagnostic{Severity: strings.ToLower(match[1]), Message: match[4]}
+
+		if len(match[3]) > 0 {
+			diagnostic.Line, _ = strconv.Atoi(match[3])
+		}
+
+		diagnostics = append(diagnostics, diagnostic)
+	}
+
+	return diagnostics
+}
+
+// Check a shader with validate, which runs the validator on a source of a stage and returns what it wrote. The
+// reflection is read from the source whether or not the validator could run
+func Validate(shader *Shader, source string, validate func(stage, source string) (string, error)) *Validation {
+	validation := &Validation{
+		Hash:        shader.Hash,
+		Stage:       shader.Stage,
+		Diagnostics: []*Diagnostic{},
+		Reflection:  Reflect(shader.Stage, source),
+	}
+
+	if len(StageExtension(shader.Stage)) == 0 {
+		validation.Error = "unknown shader stage <" + shader.Stage + ">"
+		return validation
+	}
+
+	output, err := validate(shader.Stage, source)
+
+	if err != nil {
+		validation.Error = err.Error()
+		return validation
+	}
+
+	validation.Validated = true
+	validation.Diagnostics = ParseDiagnostics(output)
+	validation.Valid = true
+
+	for _, diagnostic := range validation.Diagnostics {
+		if diagnostic.Severity == "error" {
+			validation.Valid = false
+		}
+	}
+
+	return validation
+}
+
+// A uniform's value in a retrace's state dump, alongside where and how it was declared
+type UniformValue struct {
+	Name   string      `json:"name"`
+	Value  interface{} `json:"value"`
+	Type   string      `json:"type,omitempty"`
+	Array  string      `json:"array,omitempty"`
+	Stages []string    `json:"stages,omitempty"`
+	Block  string      `json:"block,omitempty"`
+}
+
+// Match the uniforms in a state dump with their declarations in the shaders of the program that was current. Values of
+// array elements and struct members, such as lights[0].colour, are matched by the name of the variable they are part
+// of, and block members as Block.member
+func DeclaredUniforms(values map[string]interface{}, validations []*Validation) []*UniformValue {
+	var uniforms []*UniformValue
+
+	for name, value := range values {
+		uniform := &UniformValue{Name: name, Value: value}
+
+		base := name
+
+		if i := strings.IndexAny(base, "[."); i > 0 {
+			base = base[:i]
+		}
+
+		for _, validation := range validations {
+			if validation.Reflection == nil {
+				continue
+			}
+
+			found := false
+
+			for _, declaration := range validation.Uniforms {
+				if declaration.Name == base {
+					uniform.Type, uniform.Array = declaration.Type, declaration.Array
+					found = true
+				}
+			}
+
+			for _, block := range validation.Blocks {
+				if block.Storage != "uniform" {
+					continue
+				}
+
+				for _, member := range block.Members {
+					if name == block.Name+"."+member.Name || base == member.Name && len(block.Instance) == 0 ||
+						strings.HasPrefix(name, block.Name+"."+member.Name+"[") {
+						uniform.Type, uniform.Array, uniform.Block = member.Type, member.Array, block.Name
+						found = true
+					}
+				}
+			}
+
+			if found {
+				uniform.Stages = append(uniform.Stages, validation.Stage)
+			}
+		}
+
+		uniforms = append(uniforms, uniform)
+	}
+
+	sort.Slice(uniforms, func(i, j int) bool {
+		return uniforms[i].Name < uniforms[j].Name
+	})
+
+	return uniforms
+}
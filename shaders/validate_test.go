@@ -0,0 +1,199 @@
+package shaders
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDiagnostics(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+
+		// each diagnostic as its severity, line and message
+		diagnostics []string
+	}{
+		{
+			// the file name is printed first, and the summary of the errors last
+			name: "errors in a file",
+			output: `/tmp/shader123/shader.frag
+ERROR: /tmp/shader123/shader.frag:4: 'colour' : undeclared identifier
+ERROR: /tmp/shader123/shader.frag:4: 'assign' :  cannot convert from ' temp float' to ' out highp 4-component vector of float'
+ERROR: 2 compilation errors.  No code generated.
+
+
+SPIR-V is not generated for failed compile or link
+`,
+			diagnostics: []string{
+				"error 4: 'colour' : undeclared identifier",
+				"error 4: 'assign' :  cannot convert from ' temp float' to ' out highp 4-component vector of float'",
+			},
+		},
+		{
+			// older versions number the source strings rather than naming the file
+			name: "errors in a source string",
+			output: `ERROR: 0:12: 'texture2D' : no matching overloaded function found
+ERROR: 0:12: '' : compilation terminated
+ERROR: 2 compilation errors.  No code generated.`,
+			diagnostics: []string{
+				"error 12: 'texture2D' : no matching overloaded function found",
+				"error 12: '' : compilation terminated",
+			},
+		},
+		{
+			name: "warnings",
+			output: `shader.vert
+WARNING: 0:3: '#extension' : extension not supported: GL_NV_shader_buffer_load
+WARNING: shader.vert:7: 'attribute' : deprecated`,
+			diagnostics: []string{
+				"warning 3: '#extension' : extension not supported: GL_NV_shader_buffer_load",
+				"warning 7: 'attribute' : deprecated",
+			},
+		},
+		{
+			// problems found when linking have no line
+			name: "link errors",
+			output: `shader.frag
+
+Linking fragment stage:
+ERROR: Linking fragment stage: Missing entry point: Each stage requires one entry point`,
+			diagnostics: []string{
+				"error 0: Linking fragment stage: Missing entry point: Each stage requires one entry point",
+			},
+		},
+		{
+			name:   "valid shaders",
+			output: "/tmp/shader123/shader.vert\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diagnostics := []string{}
+
+			for _, diagnostic := range ParseDiagnostics(test.output) {
+				diagnostics = append(diagnostics, fmt.Sprintf("%s %d: %s", diagnostic.Severity, diagnostic.Line,
+					diagnostic.Message))
+			}
+
+			if !reflect.DeepEqual(diagnostics, nonNil(test.diagnostics)) {
+				t.Errorf("expected the diagnostics\n%s\ngot\n%s", strings.Join(test.diagnostics, "\n"),
+					strings.Join(diagnostics, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	const source = "#version 330\nuniform vec4 colour;\n"
+
+	tests := []struct {
+		name      string
+		stage     string
+		output    string
+		err       error
+		validated bool
+		valid     bool
+		error     string
+	}{
+		{name: "valid", stage: "fragment", output: "shader.frag\n", validated: true, valid: true},
+		{name: "warnings", stage: "fragment", output: "WARNING: 0:1: 'attribute' : deprecated", validated: true,
+			valid: true},
+		{name: "errors", stage: "vertex", output: "ERROR: 0:2: 'x' : undeclared identifier", validated: true},
+		{name: "validator missing", stage: "vertex", err: errors.New("executable file not found in $PATH"),
+			error: "executable file not found in $PATH"},
+		{name: "unknown stage", stage: "mesh", error: "unknown shader stage <mesh>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validate := func(stage, s string) (string, error) {
+				if stage != test.stage || s != source {
+					t.Errorf("expected the %s source to be validated, got the %s source\n%s", test.stage, stage, s)
+				}
+
+				return test.output, test.err
+			}
+
+			validation := Validate(&Shader{Hash: "abc", Stage: test.stage}, source, validate)
+
+			if validation.Validated != test.validated || validation.Valid != test.valid ||
+				validation.Error != test.error {
+				t.Errorf("expected validated %t, valid %t and error <%s>, got %t, %t and <%s>", test.validated,
+					test.valid, test.error, validation.Validated, validation.Valid, validation.Error)
+			}
+
+			// the declarations are read whether or not the validator ran
+			if len(validation.Uniforms) != 1 || validation.Uniforms[0].Name != "colour" {
+				t.Errorf("expected the uniform colour to be reflected, got %v", validation.Uniforms)
+			}
+		})
+	}
+}
+
+func TestDeclaredUniforms(t *testing.T) {
+	vertex := Validate(&Shader{Stage: "vertex"}, `#version 330
+uniform mat4 mvp;
+uniform vec4 colour;
+layout(std140) uniform Lights {
+    vec4 positions[4];
+    float count;
+} lights;
+`, func(stage, source string) (string, error) { return "", nil })
+
+	fragment := Validate(&Shader{Stage: "fragment"}, `#version 330
+struct Material { vec4 diffuse; float shininess; };
+uniform Material materials[2];
+uniform vec4 colour;
+uniform Fog {
+    vec4 fogColour;
+};
+`, func(stage, source string) (string, error) { return "", nil })
+
+	// the values of array elements, struct members and block members are matched by what declares them, while
+	// built-ins and uniforms no shader declares are left undeclared
+	values := map[string]interface{}{
+		"mvp":                       []interface{}{1, 0, 0, 0},
+		"colour":                    []interface{}{1, 1, 1, 1},
+		"materials[1].shininess":    32,
+		"Lights.positions[2]":       []interface{}{0, 1, 0, 1},
+		"Lights.count":              4,
+		"fogColour":                 []interface{}{0.5, 0.5, 0.5, 1},
+		"gl_ModelViewMatrix":        []interface{}{1, 0, 0, 0},
+		"Lights.positionsElsewhere": 0,
+	}
+
+	uniforms := []string{}
+
+	for _, uniform := range DeclaredUniforms(values, []*Validation{vertex, fragment}) {
+		description := []string{uniform.Name}
+
+		for _, part := range []string{uniform.Type + uniform.Array, uniform.Block, strings.Join(uniform.Stages, " ")} {
+			if len(part) == 0 {
+				part = "-"
+			}
+
+			description = append(description, part)
+		}
+
+		uniforms = append(uniforms, strings.Join(description, ", "))
+	}
+
+	want := []string{
+		"Lights.count, float, Lights, vertex",
+		"Lights.positionsElsewhere, -, -, -",
+		"Lights.positions[2], vec4[4], Lights, vertex",
+		"colour, vec4, -, vertex fragment",
+		"fogColour, vec4, Fog, fragment",
+		"gl_ModelViewMatrix, -, -, -",
+		"materials[1].shininess, Material[2], -, fragment",
+		"mvp, mat4, -, vertex",
+	}
+
+	if !reflect.DeepEqual(uniforms, want) {
+		t.Errorf("expected the uniforms\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(uniforms, "\n"))
+	}
+}
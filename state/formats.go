@@ -0,0 +1,128 @@
+package state
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// sized colour formats named by their components, bits per component and type, e.g. GL_RGBA16F
+var sizedFormatPattern = regexp.MustCompile(`^GL_(RGBA|RGB|RG|R)(8|16|32)(F|I|UI|_SNORM)?$`)
+
+// ASTC formats name the size of their blocks, e.g. GL_COMPRESSED_RGBA_ASTC_8x8_KHR
+var astcPattern = regexp.MustCompile(`ASTC_(\d+)x(\d+)`)
+
+// the bytes per texel of the formats that don't follow the pattern of sizedFormatPattern
+var formatSizes = map[string]int64{
+	"GL_R3_G3_B2":            1,
+	"GL_ALPHA8":              1,
+	"GL_LUMINANCE8":          1,
+	"GL_INTENSITY8":          1,
+	"GL_STENCIL_INDEX8":      1,
+	"GL_LUMINANCE8_ALPHA8":   2,
+	"GL_RGB565":              2,
+	"GL_RGB5":                2,
+	"GL_RGB5_A1":             2,
+	"GL_RGBA4":               2,
+	"GL_DEPTH_COMPONENT16":   2,
+	"GL_ALPHA16":             2,
+	"GL_LUMINANCE16":         2,
+	"GL_SRGB8":               4,
+	"GL_SRGB8_ALPHA8":        4,
+	"GL_RGB10":               4,
+	"GL_RGB10_A2":            4,
+	"GL_RGB10_A2UI":          4,
+	"GL_R11F_G11F_B10F":      4,
+	"GL_RGB9_E5":             4,
+	"GL_DEPTH_COMPONENT24":   4,
+	"GL_DEPTH_COMPONENT32":   4,
+	"GL_DEPTH_COMPONENT32F":  4,
+	"GL_DEPTH24_STENCIL8":    4,
+	"GL_DEPTH32F_STENCIL8":   8,
+	"GL_RGB12":               8,
+	"GL_RGBA12":              8,
+	"GL_LUMINANCE16_ALPHA16": 4,
+	"GL_BGRA8":               4,
+
+	// unsized formats, as drivers typically store them
+	"GL_RED":             1,
+	"GL_ALPHA":           1,
+	"GL_LUMINANCE":       1,
+	"GL_INTENSITY":       1,
+	"GL_LUMINANCE_ALPHA": 2,
+	"GL_RG":              2,
+	"GL_RGB":             4,
+	"GL_BGR":             4,
+	"GL_RGBA":            4,
+	"GL_BGRA":            4,
+	"GL_SRGB":            4,
+	"GL_SRGB_ALPHA":      4,
+	"GL_DEPTH_COMPONENT": 4,
+	"GL_DEPTH_STENCIL":   4,
+}
+
+// The bytes an image of an internal format takes up, or 0 if the format is unknown. Three component formats of 8 and
+// 16 bits are padded to four components, as most drivers store them that way
+func imageSize(format string, width, height, depth int64) int64 {
+	format = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSuffix(format, "_EXT"), "_OES"), "_ARB")
+
+	if blockWidth, blockHeight, blockBytes, ok := compressedBlock(format); ok {
+		blocks := ((width + blockWidth - 1) / blockWidth) * ((height + blockHeight - 1) / blockHeight)
+
+		return blocks * blockBytes * depth
+	}
+
+	return width * height * depth * texelBytes(format)
+}
+
+func texelBytes(format string) int64 {
+	if size, ok := formatSizes[format]; ok {
+		return size
+	}
+
+	// legacy GL takes the number of components in place of a format
+	if components, err := strconv.Atoi(format); err == nil && components >= 1 && components <= 4 {
+		return int64(components)
+	}
+
+	match := sizedFormatPattern.FindStringSubmatch(format)
+
+	if match == nil {
+		return 0
+	}
+
+	components := int64(len(match[1]))
+	bits, _ := strconv.ParseInt(match[2], 10, 64)
+
+	if components == 3 && bits < 32 {
+		components = 4
+	}
+
+	return components * bits / 8
+}
+
+// the size of the blocks of a compressed format, and the bytes each one takes up
+func compressedBlock(format string) (int64, int64, int64, bool) {
+	if !strings.HasPrefix(format, "GL_COMPRESSED_") && !strings.HasPrefix(format, "GL_ETC1_") {
+		return 0, 0, 0, false
+	}
+
+	if match := astcPattern.FindStringSubmatch(format); match != nil {
+		width, _ := strconv.ParseInt(match[1], 10, 64)
+		height, _ := strconv.ParseInt(match[2], 10, 64)
+
+		return width, height, 16, true
+	}
+
+	switch {
+	// formats of half a byte per texel: DXT1, BC1, BC4, ETC1, ETC2 RGB and the single channel EAC formats
+	case strings.Contains(format, "S3TC_DXT1"), strings.Contains(format, "RED_RGTC1"),
+		strings.Contains(format, "ETC1"), strings.HasSuffix(format, "RGB8_ETC2"),
+		strings.HasSuffix(format, "RGB8_PUNCHTHROUGH_ALPHA1_ETC2"), strings.HasSuffix(format, "R11_EAC"),
+		strings.Contains(format, "LUMINANCE_LATC1"):
+		return 4, 4, 8, true
+	}
+
+	// everything else in use, DXT3, DXT5, BC2 to BC7, ETC2 RGBA and the two channel EAC formats, takes a byte per texel
+	return 4, 4, 16, true
+}
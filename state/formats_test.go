@@ -0,0 +1,69 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestImageSize(t *testing.T) {
+	tests := []struct {
+		format string
+		width  int64
+		height int64
+		depth  int64
+		bytes  int64
+	}{
+		// sized formats, by their components and bits, with three components of under 32 bits padded to four
+		{"GL_RGBA8", 4, 4, 1, 64},
+		{"GL_RGB8", 4, 4, 1, 64},
+		{"GL_RGB16F", 4, 4, 1, 128},
+		{"GL_RGB32F", 4, 4, 1, 192},
+		{"GL_RG16F", 4, 4, 1, 64},
+		{"GL_R8", 4, 4, 1, 16},
+		{"GL_R32UI", 4, 4, 1, 64},
+		{"GL_RGBA16_SNORM", 4, 4, 1, 128},
+		{"GL_RGBA8", 4, 4, 3, 192},
+
+		// the formats the table lists, with vendor suffixes dropped
+		{"GL_RGB565", 4, 4, 1, 32},
+		{"GL_DEPTH24_STENCIL8", 4, 4, 1, 64},
+		{"GL_DEPTH32F_STENCIL8", 4, 4, 1, 128},
+		{"GL_R11F_G11F_B10F", 4, 4, 1, 64},
+		{"GL_RGBA8_OES", 4, 4, 1, 64},
+		{"GL_DEPTH_COMPONENT24_ARB", 4, 4, 1, 64},
+		{"GL_LUMINANCE_ALPHA", 4, 4, 1, 32},
+		{"GL_RGBA", 4, 4, 1, 64},
+
+		// legacy GL's numbers of components
+		{"3", 4, 4, 1, 48},
+		{"1", 4, 4, 1, 16},
+
+		{"GL_RGBA5", 4, 4, 1, 0},
+		{"GL_UNSIGNED_BYTE", 4, 4, 1, 0},
+
+		// compressed formats, by the blocks that cover the image, partial blocks included
+		{"GL_COMPRESSED_RGB_S3TC_DXT1_EXT", 4, 4, 1, 8},
+		{"GL_COMPRESSED_RGB_S3TC_DXT1_EXT", 5, 5, 1, 32},
+		{"GL_COMPRESSED_RGBA_S3TC_DXT5_EXT", 8, 8, 1, 64},
+		{"GL_COMPRESSED_RED_RGTC1", 4, 4, 1, 8},
+		{"GL_COMPRESSED_RG_RGTC2", 4, 4, 1, 16},
+		{"GL_COMPRESSED_RGBA_BPTC_UNORM", 4, 4, 1, 16},
+		{"GL_ETC1_RGB8_OES", 8, 8, 1, 32},
+		{"GL_COMPRESSED_RGB8_ETC2", 4, 4, 1, 8},
+		{"GL_COMPRESSED_RGB8_PUNCHTHROUGH_ALPHA1_ETC2", 4, 4, 1, 8},
+		{"GL_COMPRESSED_RGBA8_ETC2_EAC", 4, 4, 1, 16},
+		{"GL_COMPRESSED_R11_EAC", 4, 4, 1, 8},
+		{"GL_COMPRESSED_RG11_EAC", 4, 4, 1, 16},
+		{"GL_COMPRESSED_RGBA_ASTC_8x8_KHR", 10, 10, 1, 64},
+		{"GL_COMPRESSED_RGBA_ASTC_12x10_KHR", 12, 10, 1, 16},
+		{"GL_COMPRESSED_RGBA_S3TC_DXT5_EXT", 4, 4, 6, 96},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %dx%dx%d", test.format, test.width, test.height, test.depth), func(t *testing.T) {
+			if bytes := imageSize(test.format, test.width, test.height, test.depth); bytes != test.bytes {
+				t.Errorf("expected %d bytes, got %d", test.bytes, bytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package state
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"sort"
+	"strings"
+)
+
+const (
+	Leaked            = "leaked"
+	DeletedWhileBound = "deletedWhileBound"
+	UsedAfterDelete   = "usedAfterDelete"
+)
+
+// A problem with an object's lifetime. Call and Frame are where it happened: the deletion or use, or for a leak the
+// call that created the object. Created and CreatedFrame are always the call that created the object
+type Finding struct {
+	Problem      string   `json:"problem"`
+	Kind         string   `json:"kind"`
+	Name         string   `json:"name"`
+	Call         string   `json:"call"`
+	Frame        int      `json:"frame"`
+	Function     string   `json:"function"`
+	Created      string   `json:"created"`
+	CreatedFrame int      `json:"createdFrame"`
+	Bindings     []string `json:"bindings,omitempty"`
+}
+
+// How many objects of a kind were created, deleted and never deleted
+type LifetimeCounts struct {
+	Created int `json:"created"`
+	Deleted int `json:"deleted"`
+	Leaked  int `json:"leaked"`
+}
+
+type LeakReport struct {
+	Kinds             map[string]*LifetimeCounts `json:"kinds"`
+	Leaked            []*Finding                 `json:"leaked"`
+	DeletedWhileBound []*Finding                 `json:"deletedWhileBound"`
+	UsedAfterDelete   []*Finding                 `json:"usedAfterDelete"`
+}
+
+// one lifetime of an object name; names can be handed out again once they are deleted
+type lifetime struct {
+	kind     string
+	name     string
+	created  string
+	frame    int
+	function string
+	deleted  bool
+	reported bool
+}
+
+// The kind of object each call creates or deletes, and the position of the argument holding the names, or -1 for the
+// calls that return the name of the object they create
+var generators = map[string]struct {
+	kind  string
+	names int
+}{
+	"glGenBuffers":               {"buffer", 1},
+	"glGenTextures":              {"texture", 1},
+	"glGenFramebuffers":          {"framebuffer", 1},
+	"glGenRenderbuffers":         {"renderbuffer", 1},
+	"glGenVertexArrays":          {"vertexarray", 1},
+	"glGenSamplers":              {"sampler", 1},
+	"glGenQueries":               {"query", 1},
+	"glGenProgramPipelines":      {"pipeline", 1},
+	"glCreateBuffers":            {"buffer", 1},
+	"glCreateTextures":           {"texture", 2},
+	"glCreateFramebuffers":       {"framebuffer", 1},
+	"glCreateRenderbuffers":      {"renderbuffer", 1},
+	"glCreateVertexArrays":       {"vertexarray", 1},
+	"glCreateSamplers":           {"sampler", 1},
+	"glCreateQueries":            {"query", 2},
+	"glCreateProgramPipelines":   {"pipeline", 1},
+	"glDeleteBuffers":            {"buffer", 1},
+	"glDeleteTextures":           {"texture", 1},
+	"glDeleteFramebuffers":       {"framebuffer", 1},
+	"glDeleteRenderbuffers":      {"renderbuffer", 1},
+	"glDeleteVertexArrays":       {"vertexarray", 1},
+	"glDeleteSamplers":           {"sampler", 1},
+	"glDeleteQueries":            {"query", 1},
+	"glDeleteProgramPipelines":   {"pipeline", 1},
+	"glCreateProgram":            {"program", -1},
+	"glCreateProgramObject":      {"program", -1},
+	"glCreateShaderProgramv":     {"program", -1},
+	"glCreateShaderProgramEXT":   {"program", -1},
+	"glCreateShader":             {"shader", -1},
+	"glCreateShaderObject":       {"shader", -1},
+	"glDeleteProgram":            {"program", 0},
+	"glDeleteShader":             {"shader", 0},
+	"glDeleteTransformFeedbacks": {"transformfeedback", 1},
+	"glGenTransformFeedbacks":    {"transformfeedback", 1},
+}
+
+// Follows the creation, use and deletion of the GL objects in a trace. Object names are shared between contexts, so
+// they are tracked for the trace as a whole, while bindings are checked against the context a deletion is made on
+type LifetimeTracker struct {
+	objects map[string]*lifetime
+	state   *Tracker
+	counts  map[string]*LifetimeCounts
+	report  *LeakReport
+}
+
+// A lifetime tracker reading bindings from the state tracker given, which is shown each call before the state tracker
+// applies it
+func NewLifetimeTracker(tracker *Tracker) *LifetimeTracker {
+	return &LifetimeTracker{
+		objects: map[string]*lifetime{},
+		state:   tracker,
+		counts:  map[string]*LifetimeCounts{},
+		report: &LeakReport{
+			Leaked:            []*Finding{},
+			DeletedWhileBound: []*Finding{},
+			UsedAfterDelete:   []*Finding{},
+		},
+	}
+}
+
+// Follow a call of a frame, before the state tracker applies it
+func (t *LifetimeTracker) Call(frame int, call *parsers.Call) {
+	function := call.FunctionName
+
+	if _, ok := generators[function]; !ok {
+		function = parsers.Vendorless(function)
+	}
+
+	generator, ok := generators[function]
+
+	switch {
+	// glDeleteObjectARB deletes shaders and programs alike
+	case function == "glDeleteObject":
+		for _, name := range objectNames(call, 0) {
+			if _, ok := t.objects["shader:"+name]; ok {
+				t.delete(frame, call, "shader", name)
+			} else {
+				t.delete(frame, call, "program", name)
+			}
+		}
+
+	case ok && strings.HasPrefix(function, "glDelete"):
+		for _, name := range objectNames(call, generator.names) {
+			t.delete(frame, call, generator.kind, name)
+		}
+
+	case ok && generator.names < 0:
+		if call.Return != nil && call.Return.Text != "0" {
+			t.create(frame, call, generator.kind, call.Return.Text)
+		}
+
+	case ok:
+		for _, name := range objectNames(call, generator.names) {
+			t.create(frame, call, generator.kind, name)
+		}
+
+	// asking whether a name is an object is not a use of it
+	case strings.HasPrefix(function, "glIs"):
+
+	default:
+		for _, arg := range call.Args {
+			kind, ok := parsers.ObjectKind(call.FunctionName, arg.Name)
+
+			if !ok {
+				continue
+			}
+
+			for _, name := range ValueNames(arg.Value) {
+				t.use(frame, call, kind, name)
+			}
+		}
+	}
+}
+
+func (t *LifetimeTracker) create(frame int, call *parsers.Call, kind, name string) {
+	t.objects[kind+":"+name] = &lifetime{kind: kind, name: name, created: call.ID, frame: frame, function: call.FunctionName}
+	t.count(kind).Created++
+}
+
+func (t *LifetimeTracker) delete(frame int, call *parsers.Call, kind, name string) {
+	object, ok := t.objects[kind+":"+name]
+
+	// deleting a name that was never created, or was already deleted, is silently ignored by GL
+	if !ok || object.deleted {
+		return
+	}
+
+	object.deleted = true
+	t.count(kind).Deleted++
+
+	if bindings := t.bindings(call.Context, kind, name); len(bindings) > 0 {
+		finding := object.finding(DeletedWhileBound, frame, call)
+		finding.Bindings = bindings
+
+		t.report.DeletedWhileBound = append(t.report.DeletedWhileBound, finding)
+	}
+}
+
+func (t *LifetimeTracker) use(frame int, call *parsers.Call, kind, name string) {
+	object, ok := t.objects[kind+":"+name]
+
+	switch {
+	case !ok:
+		// binding a name that was never generated creates the object in compatibility contexts
+		t.create(frame, call, kind, name)
+
+	// each deleted object is only reported the first time it is used
+	case object.deleted && !object.reported:
+		t.report.UsedAfterDelete = append(t.report.UsedAfterDelete, object.finding(UsedAfterDelete, frame, call))
+		object.reported = true
+	}
+}
+
+func (t *LifetimeTracker) count(kind string) *LifetimeCounts {
+	if t.counts[kind] == nil {
+		t.counts[kind] = &LifetimeCounts{}
+	}
+
+	return t.counts[kind]
+}
+
+// where an object is bound on a context, as the binding points of State
+func (t *LifetimeTracker) bindings(context, kind, name string) []string {
+	s, ok := t.state.Contexts[context]
+
+	if !ok {
+		return nil
+	}
+
+	var bound []string
+
+	switch kind {
+	case "buffer":
+		for target, buffer := range s.Buffers {
+			if buffer == name {
+				bound = append(bound, target)
+			}
+		}
+	case "texture":
+		for unit, targets := range s.Textures {
+			for target, texture := range targets {
+				if texture == name {
+					bound = append(bound, unit+" "+target)
+				}
+			}
+		}
+	case "sampler":
+		for unit, sampler := range s.Samplers {
+			if sampler == name {
+				bound = append(bound, unit)
+			}
+		}
+	case "framebuffer":
+		if s.DrawFramebuffer == name {
+			bound = append(bound, "GL_DRAW_FRAMEBUFFER")
+		}
+
+		if s.ReadFramebuffer == name {
+			bound = append(bound, "GL_READ_FRAMEBUFFER")
+		}
+	case "renderbuffer":
+		if s.Renderbuffer == name {
+			bound = append(bound, "GL_RENDERBUFFER")
+		}
+	case "vertexarray":
+		if s.VertexArray == name {
+			bound = append(bound, "GL_VERTEX_ARRAY_BINDING")
+		}
+	case "pipeline":
+		if s.ProgramPipeline == name {
+			bound = append(bound, "GL_PROGRAM_PIPELINE_BINDING")
+		}
+	case "program":
+		if s.Program == name {
+			bound = append(bound, "GL_CURRENT_PROGRAM")
+		}
+	}
+
+	sort.Strings(bound)
+
+	return bound
+}
+
+// The report of the calls followed so far, counting every object still alive as leaked
+func (t *LifetimeTracker) Report() *LeakReport {
+	report := &LeakReport{
+		Kinds:             map[string]*LifetimeCounts{},
+		Leaked:            []*Finding{},
+		DeletedWhileBound: t.report.DeletedWhileBound,
+		UsedAfterDelete:   t.report.UsedAfterDelete,
+	}
+
+	for kind, counts := range t.counts {
+		report.Kinds[kind] = &LifetimeCounts{Created: counts.Created, Deleted: counts.Deleted}
+	}
+
+	for _, object := range t.objects {
+		if object.deleted {
+			continue
+		}
+
+		finding := object.finding(Leaked, object.frame, nil)
+
+		report.Leaked = append(report.Leaked, finding)
+		report.Kinds[object.kind].Leaked++
+	}
+
+	sort.Slice(report.Leaked, func(i, j int) bool {
+		return callOrder(report.Leaked[i].Call, report.Leaked[j].Call)
+	})
+
+	return report
+}
+
+// a finding about an object at a call; without a call, at the call that created it
+func (object *lifetime) finding(problem string, frame int, call *parsers.Call) *Finding {
+	finding := &Finding{
+		Problem:      problem,
+		Kind:         object.kind,
+		Name:         object.name,
+		Call:         object.created,
+		Frame:        frame,
+		Function:     object.function,
+		Created:      object.created,
+		CreatedFrame: object.frame,
+	}
+
+	if call != nil {
+		finding.Call = call.ID
+		finding.Function = call.FunctionName
+	}
+
+	return finding
+}
+
+// the object names at position i of a call's arguments, whether one name or an array of them
+func objectNames(call *parsers.Call, i int) []string {
+	if i >= len(call.Args) {
+		return nil
+	}
+
+	return ValueNames(call.Args[i].Value)
+}
+
+// The object names a value gives, whether one name or an array of them. Zero names no object
+func ValueNames(v *parsers.Value) []string {
+	if v == nil {
+		return nil
+	}
+
+	switch v.Kind {
+	case parsers.IntValue, parsers.UintValue:
+		// zero unbinds rather than naming an object
+		if v.Text != "0" {
+			return []string{v.Text}
+		}
+
+	case parsers.ArrayValue:
+		var found []string
+
+		for _, element := range v.Elements {
+			found = append(found, ValueNames(element)...)
+		}
+
+		return found
+	}
+
+	return nil
+}
+
+func callOrder(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+
+	return a < b
+}
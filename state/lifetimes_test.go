@@ -0,0 +1,168 @@
+package state
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLifetimeTracker(t *testing.T) {
+	tests := []struct {
+		name string
+		dump string
+
+		// each finding as its problem, object, function and call, frame, and the bindings of deletes while bound
+		findings []string
+
+		// created, deleted and leaked by kind
+		counts map[string]string
+	}{
+		{
+			name: "leaks",
+			dump: `0 glGenTextures(n = 2, textures = {1, 2})
+1 glDeleteTextures(n = 1, textures = &1)
+2 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+3 glGenBuffers(n = 1, buffers = &3)`,
+			findings: []string{
+				"leaked texture 2 by glGenTextures at 0 in frame 0",
+				"leaked buffer 3 by glGenBuffers at 3 in frame 1",
+			},
+			counts: map[string]string{"texture": "2 1 1", "buffer": "1 0 1"},
+		},
+		{
+			name: "deleted while bound",
+			dump: `0 glGenBuffers(n = 1, buffers = &1)
+1 glBindBuffer(target = GL_ARRAY_BUFFER, buffer = 1)
+2 glBindBufferBase(target = GL_UNIFORM_BUFFER, index = 2, buffer = 1)
+3 glGenTextures(n = 1, textures = &2)
+4 glBindTexture(target = GL_TEXTURE_2D, texture = 2)
+5 glDeleteBuffers(n = 1, buffers = &1)
+6 glBindTexture(target = GL_TEXTURE_2D, texture = 0)
+7 glDeleteTextures(n = 1, textures = &2)`,
+			findings: []string{
+				"deletedWhileBound buffer 1 by glDeleteBuffers at 5 in frame 0 " +
+					"[GL_ARRAY_BUFFER GL_UNIFORM_BUFFER GL_UNIFORM_BUFFER[2]]",
+			},
+			counts: map[string]string{"texture": "1 1 0", "buffer": "1 1 0"},
+		},
+		{
+			name: "programs and shaders",
+			dump: `0 glCreateProgram() = 3
+1 glCreateShader(type = GL_VERTEX_SHADER) = 1
+2 glUseProgram(program = 3)
+3 glDeleteShader(shader = 1)
+4 glDeleteProgram(program = 3)`,
+			findings: []string{
+				"deletedWhileBound program 3 by glDeleteProgram at 4 in frame 0 [GL_CURRENT_PROGRAM]",
+			},
+			counts: map[string]string{"program": "1 1 0", "shader": "1 1 0"},
+		},
+		{
+			name: "glDeleteObjectARB",
+			dump: `0 glCreateShaderObjectARB(shaderType = GL_VERTEX_SHADER) = 4
+1 glCreateProgramObjectARB() = 5
+2 glDeleteObjectARB(obj = 4)
+3 glDeleteObjectARB(obj = 5)`,
+			counts: map[string]string{"program": "1 1 0", "shader": "1 1 0"},
+		},
+		{
+			// only the first use is reported, and names can be handed out again once deleted
+			name: "used after delete",
+			dump: `0 glGenTextures(n = 1, textures = &1)
+1 glDeleteTextures(n = 1, textures = &1)
+2 glIsTexture(texture = 1) = GL_FALSE
+3 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+4 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+5 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+6 glGenTextures(n = 1, textures = &1)
+7 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+8 glDeleteTextures(n = 1, textures = &1)`,
+			findings: []string{
+				"usedAfterDelete texture 1 by glBindTexture at 4 in frame 1",
+				"deletedWhileBound texture 1 by glDeleteTextures at 8 in frame 1 [GL_TEXTURE0 GL_TEXTURE_2D]",
+			},
+			counts: map[string]string{"texture": "2 2 0"},
+		},
+		{
+			// deleting a name never created, or deleting it twice, is ignored, and binding a name never created
+			// creates it
+			name: "names never generated",
+			dump: `0 glDeleteBuffers(n = 1, buffers = &9)
+1 glGenBuffers(n = 1, buffers = &1)
+2 glDeleteBuffers(n = 1, buffers = &1)
+3 glDeleteBuffers(n = 1, buffers = &1)
+4 glBindTexture(target = GL_TEXTURE_2D, texture = 5)`,
+			findings: []string{
+				"leaked texture 5 by glBindTexture at 4 in frame 0",
+			},
+			counts: map[string]string{"texture": "1 0 1", "buffer": "1 1 0"},
+		},
+		{
+			// bindings belong to the context they were made on, while names are shared by them all
+			name: "contexts",
+			dump: `0 glXMakeCurrent(dpy = 0x5581ac2f06b0, drawable = 62914562, ctx = 0x5581ac3d1e40) = True
+1 glGenBuffers(n = 1, buffers = &1)
+2 glBindBuffer(target = GL_ARRAY_BUFFER, buffer = 1)
+3 glXMakeCurrent(dpy = 0x5581ac2f06b0, drawable = 62914562, ctx = 0x5581ac3d2000) = True
+4 glDeleteBuffers(n = 1, buffers = &1)`,
+			counts: map[string]string{"buffer": "1 1 0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tracker := NewTracker()
+			lifetimes := NewLifetimeTracker(tracker)
+
+			// as the ingester does, each call is shown to the lifetime tracker before the state tracker applies it
+			for _, frame := range parsers.ParseDump(test.dump).Frames {
+				for _, call := range frame.Calls {
+					lifetimes.Call(frame.ID, call)
+					tracker.AddCall(call)
+				}
+			}
+
+			report := lifetimes.Report()
+
+			findings := []string{}
+
+			for _, group := range [][]*Finding{report.UsedAfterDelete, report.DeletedWhileBound, report.Leaked} {
+				for _, finding := range group {
+					findings = append(findings, describeFinding(finding))
+				}
+			}
+
+			want := append([]string{}, test.findings...)
+
+			sort.Strings(findings)
+			sort.Strings(want)
+
+			if !reflect.DeepEqual(findings, want) {
+				t.Errorf("expected the findings\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(findings, "\n"))
+			}
+
+			counts := map[string]string{}
+
+			for kind, c := range report.Kinds {
+				counts[kind] = fmt.Sprintf("%d %d %d", c.Created, c.Deleted, c.Leaked)
+			}
+
+			if !reflect.DeepEqual(counts, test.counts) {
+				t.Errorf("expected the counts %v, got %v", test.counts, counts)
+			}
+		})
+	}
+}
+
+func describeFinding(f *Finding) string {
+	description := fmt.Sprintf("%s %s %s by %s at %s in frame %d", f.Problem, f.Kind, f.Name, f.Function, f.Call, f.Frame)
+
+	if len(f.Bindings) > 0 {
+		description += fmt.Sprintf(" %v", f.Bindings)
+	}
+
+	return description
+}
@@ -0,0 +1,354 @@
+package state
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"sort"
+	"strings"
+)
+
+// The estimated memory of the textures, buffers and renderbuffers alive at the end of a frame, and how much was
+// allocated and freed during it
+type FrameMemory struct {
+	Frame         int   `json:"frame"`
+	Textures      int64 `json:"textures"`
+	Buffers       int64 `json:"buffers"`
+	Renderbuffers int64 `json:"renderbuffers"`
+	Total         int64 `json:"total"`
+	Allocated     int64 `json:"allocated"`
+	Freed         int64 `json:"freed"`
+}
+
+// The estimated memory of one object, with the call and frame that last allocated storage for it
+type ObjectMemory struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Bytes  int64  `json:"bytes"`
+	Format string `json:"format,omitempty"`
+	Call   string `json:"call"`
+	Frame  int    `json:"frame"`
+}
+
+// The memory of a trace at the end of every frame, with the frame it peaked at and the objects alive then and at the
+// end of the trace, largest first
+type MemoryReport struct {
+	Timeline    []*FrameMemory  `json:"timeline"`
+	Peak        *FrameMemory    `json:"peak"`
+	PeakObjects []*ObjectMemory `json:"peakObjects"`
+	Objects     []*ObjectMemory `json:"objects"`
+}
+
+// an object's storage, made up of images for textures, each keyed by face and level
+type allocation struct {
+	ObjectMemory
+	images map[string]int64
+}
+
+// the faces of a cube map are allocated separately, but are all part of the texture bound to GL_TEXTURE_CUBE_MAP
+var cubeFaces = map[string]bool{
+	"GL_TEXTURE_CUBE_MAP_POSITIVE_X": true, "GL_TEXTURE_CUBE_MAP_NEGATIVE_X": true,
+	"GL_TEXTURE_CUBE_MAP_POSITIVE_Y": true, "GL_TEXTURE_CUBE_MAP_NEGATIVE_Y": true,
+	"GL_TEXTURE_CUBE_MAP_POSITIVE_Z": true, "GL_TEXTURE_CUBE_MAP_NEGATIVE_Z": true,
+}
+
+// Estimates the GPU memory a trace allocates from the sizes and formats it gives textures, buffers and renderbuffers.
+// What the driver really allocates depends on alignment, padding and compression this can't see
+type MemoryTracker struct {
+	state   *Tracker
+	objects map[string]*allocation
+	frame   *FrameMemory
+	report  *MemoryReport
+}
+
+// A memory tracker reading bindings from the state tracker given, which is shown each call before the state tracker
+// applies it
+func NewMemoryTracker(tracker *Tracker) *MemoryTracker {
+	return &MemoryTracker{
+		state:   tracker,
+		objects: map[string]*allocation{},
+		report: &MemoryReport{
+			Timeline:    []*FrameMemory{},
+			PeakObjects: []*ObjectMemory{},
+			Objects:     []*ObjectMemory{},
+		},
+	}
+}
+
+// End a frame, adding the memory alive at the end of it to the timeline
+func (t *MemoryTracker) EndFrame(frame int) {
+	t.begin(frame)
+
+	for _, object := range t.objects {
+		switch object.Kind {
+		case "texture":
+			t.frame.Textures += object.Bytes
+		case "buffer":
+			t.frame.Buffers += object.Bytes
+		case "renderbuffer":
+			t.frame.Renderbuffers += object.Bytes
+		}
+	}
+
+	t.frame.Total = t.frame.Textures + t.frame.Buffers + t.frame.Renderbuffers
+
+	t.report.Timeline = append(t.report.Timeline, t.frame)
+
+	if t.report.Peak == nil || t.frame.Total > t.report.Peak.Total {
+		t.report.Peak = t.frame
+		t.report.PeakObjects = t.live()
+	}
+
+	t.frame = nil
+}
+
+// The memory of the frames followed so far
+func (t *MemoryTracker) Report() *MemoryReport {
+	t.report.Objects = t.live()
+
+	return t.report
+}
+
+// Follow a call of a frame, before the state tracker applies it
+func (t *MemoryTracker) Call(frame int, call *parsers.Call) {
+	t.begin(frame)
+
+	s := t.state.context(call.Context)
+
+	function := parsers.Vendorless(call.FunctionName)
+
+	switch function {
+	case "glTexImage1D", "glTexImage2D", "glTexImage3D", "glCompressedTexImage1D", "glCompressedTexImage2D",
+		"glCompressedTexImage3D", "glTexImage2DMultisample", "glTexImage3DMultisample":
+		target := arg(call, 0)
+
+		if strings.Contains(target, "PROXY") {
+			break
+		}
+
+		boundTarget := target
+
+		if cubeFaces[target] {
+			boundTarget = "GL_TEXTURE_CUBE_MAP"
+		}
+
+		texture := s.Textures[s.ActiveTexture][boundTarget]
+
+		if len(texture) == 0 || texture == "0" {
+			break
+		}
+
+		var bytes int64
+
+		format := arg(call, 2)
+
+		switch {
+		case strings.HasPrefix(function, "glCompressed"):
+			bytes, _ = call.Arg("imageSize").Integer()
+		case strings.HasSuffix(function, "Multisample"):
+			samples, _ := call.Arg("samples").Integer()
+			bytes = samples * imageSize(format, dimension(call, "width"), dimension(call, "height"), dimension(call, "depth"))
+		default:
+			bytes = imageSize(format, dimension(call, "width"), dimension(call, "height"), dimension(call, "depth"))
+		}
+
+		t.image(frame, call, "texture", texture, format, target+" "+arg(call, 1), bytes)
+
+	case "glTexStorage1D", "glTexStorage2D", "glTexStorage3D", "glTexStorage2DMultisample",
+		"glTexStorage3DMultisample":
+		texture := s.Textures[s.ActiveTexture][arg(call, 0)]
+
+		if len(texture) > 0 && texture != "0" {
+			t.storage(frame, call, function, arg(call, 0), texture)
+		}
+
+	case "glTextureStorage1D", "glTextureStorage2D", "glTextureStorage3D", "glTextureStorage2DMultisample",
+		"glTextureStorage3DMultisample":
+		texture := arg(call, 0)
+
+		t.storage(frame, call, function, t.state.TextureTargets[texture], texture)
+
+	case "glBufferData", "glBufferStorage":
+		buffer := s.Buffers[arg(call, 0)]
+
+		if len(buffer) > 0 && buffer != "0" {
+			bytes, _ := call.Arg("size").Integer()
+			t.image(frame, call, "buffer", buffer, "", "", bytes)
+		}
+
+	case "glNamedBufferData", "glNamedBufferStorage":
+		bytes, _ := call.Arg("size").Integer()
+		t.image(frame, call, "buffer", arg(call, 0), "", "", bytes)
+
+	case "glRenderbufferStorage", "glRenderbufferStorageMultisample":
+		if s.Renderbuffer != "0" {
+			t.renderbuffer(frame, call, s.Renderbuffer)
+		}
+
+	case "glNamedRenderbufferStorage", "glNamedRenderbufferStorageMultisample":
+		t.renderbuffer(frame, call, arg(call, 0))
+
+	case "glDeleteTextures":
+		t.free(call, "texture")
+
+	case "glDeleteBuffers":
+		t.free(call, "buffer")
+
+	case "glDeleteRenderbuffers":
+		t.free(call, "renderbuffer")
+	}
+}
+
+func (t *MemoryTracker) begin(frame int) {
+	if t.frame == nil {
+		t.frame = &FrameMemory{Frame: frame}
+	}
+}
+
+// allocate immutable storage for every level of a texture
+func (t *MemoryTracker) storage(frame int, call *parsers.Call, function, target, texture string) {
+	if len(call.Args) < 3 {
+		return
+	}
+
+	// glTexStorage2DMultisample takes samples in place of levels
+	levels, _ := call.Args[1].Value.Integer()
+	samples := int64(1)
+
+	if strings.HasSuffix(function, "Multisample") {
+		samples, levels = levels, 1
+	}
+
+	format := arg(call, 2)
+	width, height, depth := dimension(call, "width"), dimension(call, "height"), dimension(call, "depth")
+
+	var bytes int64
+
+	for level := int64(0); level < levels; level++ {
+		bytes += samples * imageSize(format, width, height, depth)
+
+		width, height = half(width), half(height)
+
+		// the layers of array textures keep their number at every level
+		if target == "GL_TEXTURE_3D" {
+			depth = half(depth)
+		}
+	}
+
+	if target == "GL_TEXTURE_CUBE_MAP" {
+		bytes *= 6
+	}
+
+	object := t.object("texture", texture)
+
+	// storage replaces every image the texture had
+	t.frame.Freed += object.Bytes
+	object.Bytes = 0
+	object.images = map[string]int64{}
+
+	t.image(frame, call, "texture", texture, format, "storage", bytes)
+}
+
+func (t *MemoryTracker) renderbuffer(frame int, call *parsers.Call, renderbuffer string) {
+	samples := int64(1)
+
+	if value := call.Arg("samples"); value != nil {
+		samples, _ = value.Integer()
+
+		// zero samples means a single sample
+		if samples < 1 {
+			samples = 1
+		}
+	}
+
+	format := call.Arg("internalformat")
+
+	if format == nil {
+		return
+	}
+
+	bytes := samples * imageSize(format.Text, dimension(call, "width"), dimension(call, "height"), 1)
+
+	t.image(frame, call, "renderbuffer", renderbuffer, format.Text, "storage", bytes)
+}
+
+// record the storage of one image of an object, replacing whatever the image held before
+func (t *MemoryTracker) image(frame int, call *parsers.Call, kind, name, format, image string, bytes int64) {
+	object := t.object(kind, name)
+
+	previous := object.images[image]
+
+	object.images[image] = bytes
+	object.Bytes += bytes - previous
+	object.Format = format
+	object.Call = call.ID
+	object.Frame = frame
+
+	t.frame.Allocated += bytes
+	t.frame.Freed += previous
+}
+
+func (t *MemoryTracker) object(kind, name string) *allocation {
+	object, ok := t.objects[kind+":"+name]
+
+	if !ok {
+		object = &allocation{ObjectMemory: ObjectMemory{Kind: kind, Name: name}, images: map[string]int64{}}
+		t.objects[kind+":"+name] = object
+	}
+
+	return object
+}
+
+func (t *MemoryTracker) free(call *parsers.Call, kind string) {
+	if len(call.Args) < 2 {
+		return
+	}
+
+	for _, name := range ValueNames(call.Args[1].Value) {
+		if object, ok := t.objects[kind+":"+name]; ok {
+			t.frame.Freed += object.Bytes
+			delete(t.objects, kind+":"+name)
+		}
+	}
+}
+
+// the objects holding memory, largest first
+func (t *MemoryTracker) live() []*ObjectMemory {
+	objects := []*ObjectMemory{}
+
+	for _, object := range t.objects {
+		if object.Bytes > 0 {
+			copied := object.ObjectMemory
+			objects = append(objects, &copied)
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].Bytes != objects[j].Bytes {
+			return objects[i].Bytes > objects[j].Bytes
+		}
+
+		return objects[i].Kind+":"+objects[i].Name < objects[j].Kind+":"+objects[j].Name
+	})
+
+	return objects
+}
+
+// a dimension of an image, which is 1 when the call doesn't have it, as for the height of a 1D texture
+func dimension(call *parsers.Call, name string) int64 {
+	value := call.Arg(name)
+
+	if value == nil {
+		return 1
+	}
+
+	n, _ := value.Integer()
+
+	return n
+}
+
+func half(n int64) int64 {
+	if n > 1 {
+		return n / 2
+	}
+
+	return 1
+}
@@ -0,0 +1,173 @@
+package state
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMemoryTracker(t *testing.T) {
+	tests := []struct {
+		name string
+		dump string
+
+		// each frame as the bytes of textures, buffers and renderbuffers alive at its end, and allocated and freed
+		// during it
+		timeline []string
+		peak     int
+
+		// the objects alive at the end, largest first
+		objects []string
+	}{
+		{
+			name: "texture images",
+			dump: `0 glGenTextures(n = 1, textures = &1)
+1 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+2 glTexImage2D(target = GL_TEXTURE_2D, level = 0, internalformat = GL_RGBA8, width = 16, height = 16, border = 0, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)
+3 glTexImage2D(target = GL_TEXTURE_2D, level = 1, internalformat = GL_RGBA8, width = 8, height = 8, border = 0, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)
+4 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+5 glTexImage2D(target = GL_TEXTURE_2D, level = 0, internalformat = GL_RGBA16F, width = 16, height = 16, border = 0, format = GL_RGBA, type = GL_HALF_FLOAT, pixels = NULL)
+6 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+7 glGenTextures(n = 1, textures = &2)
+8 glBindTexture(target = GL_TEXTURE_2D, texture = 2)
+9 glTexImage2D(target = GL_TEXTURE_2D, level = 0, internalformat = GL_R8, width = 4, height = 4, border = 0, format = GL_RED, type = GL_UNSIGNED_BYTE, pixels = NULL)
+10 glDeleteTextures(n = 1, textures = &1)`,
+			timeline: []string{
+				"frame 0: 1280 0 0, +1280 -0",
+				"frame 1: 2304 0 0, +2048 -1024",
+				"frame 2: 16 0 0, +16 -2304",
+			},
+			peak:    1,
+			objects: []string{"texture 2: 16 GL_R8 at 9 in frame 2"},
+		},
+		{
+			// proxies and textures that aren't bound allocate nothing, and the faces of a cube map are one texture
+			name: "texture targets",
+			dump: `0 glTexImage2D(target = GL_TEXTURE_2D, level = 0, internalformat = GL_RGBA8, width = 16, height = 16, border = 0, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)
+1 glBindTexture(target = GL_TEXTURE_2D, texture = 1)
+2 glTexImage2D(target = GL_PROXY_TEXTURE_2D, level = 0, internalformat = GL_RGBA8, width = 16, height = 16, border = 0, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)
+3 glActiveTexture(texture = GL_TEXTURE1)
+4 glBindTexture(target = GL_TEXTURE_CUBE_MAP, texture = 3)
+5 glTexImage2D(target = GL_TEXTURE_CUBE_MAP_POSITIVE_X, level = 0, internalformat = GL_RGBA8, width = 4, height = 4, border = 0, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)
+6 glTexImage2D(target = GL_TEXTURE_CUBE_MAP_NEGATIVE_X, level = 0, internalformat = GL_RGBA8, width = 4, height = 4, border = 0, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)
+7 glCompressedTexImage2D(target = GL_TEXTURE_CUBE_MAP_POSITIVE_Y, level = 0, internalformat = GL_COMPRESSED_RGBA_S3TC_DXT5_EXT, width = 4, height = 4, border = 0, imageSize = 16, data = blob(16))
+8 glBindTexture(target = GL_TEXTURE_2D_MULTISAMPLE, texture = 4)
+9 glTexImage2DMultisample(target = GL_TEXTURE_2D_MULTISAMPLE, samples = 4, internalformat = GL_RGBA8, width = 4, height = 4, fixedsamplelocations = GL_TRUE)`,
+			timeline: []string{"frame 0: 400 0 0, +400 -0"},
+			objects: []string{
+				"texture 4: 256 GL_RGBA8 at 9 in frame 0",
+				"texture 3: 144 GL_COMPRESSED_RGBA_S3TC_DXT5_EXT at 7 in frame 0",
+			},
+		},
+		{
+			// immutable storage covers every level, and every face of a cube map, replacing any images the texture
+			// had; the depth of 3D textures halves with each level, while the layers of arrays don't
+			name: "texture storage",
+			dump: `0 glBindTexture(target = GL_TEXTURE_CUBE_MAP, texture = 1)
+1 glTexImage2D(target = GL_TEXTURE_CUBE_MAP_POSITIVE_X, level = 0, internalformat = GL_RGBA8, width = 64, height = 64, border = 0, format = GL_RGBA, type = GL_UNSIGNED_BYTE, pixels = NULL)
+2 glTexStorage2D(target = GL_TEXTURE_CUBE_MAP, levels = 2, internalformat = GL_RGBA8, width = 4, height = 4)
+3 glCreateTextures(target = GL_TEXTURE_3D, n = 1, textures = &2)
+4 glTextureStorage3D(texture = 2, levels = 2, internalformat = GL_R8, width = 4, height = 4, depth = 4)
+5 glCreateTextures(target = GL_TEXTURE_2D_ARRAY, n = 1, textures = &3)
+6 glTextureStorage3D(texture = 3, levels = 2, internalformat = GL_R8, width = 4, height = 4, depth = 4)
+7 glBindTexture(target = GL_TEXTURE_2D_MULTISAMPLE, texture = 4)
+8 glTexStorage2DMultisample(target = GL_TEXTURE_2D_MULTISAMPLE, samples = 4, internalformat = GL_RGBA8, width = 2, height = 2, fixedsamplelocations = GL_TRUE)`,
+			timeline: []string{"frame 0: 696 0 0, +17080 -16384"},
+			objects: []string{
+				"texture 1: 480 GL_RGBA8 at 2 in frame 0",
+				"texture 3: 80 GL_R8 at 6 in frame 0",
+				"texture 2: 72 GL_R8 at 4 in frame 0",
+				"texture 4: 64 GL_RGBA8 at 8 in frame 0",
+			},
+		},
+		{
+			name: "buffers",
+			dump: `0 glGenBuffers(n = 1, buffers = &1)
+1 glBindBuffer(target = GL_ARRAY_BUFFER, buffer = 1)
+2 glBufferData(target = GL_ARRAY_BUFFER, size = 1024, data = NULL, usage = GL_STATIC_DRAW)
+3 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)
+4 glBufferData(target = GL_ARRAY_BUFFER, size = 512, data = NULL, usage = GL_STATIC_DRAW)
+5 glNamedBufferStorage(buffer = 2, size = 256, data = NULL, flags = GL_DYNAMIC_STORAGE_BIT)
+6 glBindBuffer(target = GL_ELEMENT_ARRAY_BUFFER, buffer = 0)
+7 glBufferData(target = GL_ELEMENT_ARRAY_BUFFER, size = 64, data = NULL, usage = GL_STATIC_DRAW)`,
+			timeline: []string{
+				"frame 0: 0 1024 0, +1024 -0",
+				"frame 1: 0 768 0, +768 -1024",
+			},
+			objects: []string{
+				"buffer 1: 512 at 4 in frame 1",
+				"buffer 2: 256 at 5 in frame 1",
+			},
+		},
+		{
+			// zero samples is one sample
+			name: "renderbuffers",
+			dump: `0 glBindRenderbuffer(target = GL_RENDERBUFFER, renderbuffer = 1)
+1 glRenderbufferStorageMultisample(target = GL_RENDERBUFFER, samples = 4, internalformat = GL_DEPTH24_STENCIL8, width = 8, height = 8)
+2 glNamedRenderbufferStorage(renderbuffer = 2, internalformat = GL_RGBA8, width = 2, height = 2)
+3 glNamedRenderbufferStorageMultisample(renderbuffer = 3, samples = 0, internalformat = GL_RGBA8, width = 2, height = 2)
+4 glDeleteRenderbuffers(n = 1, renderbuffers = &3)`,
+			timeline: []string{"frame 0: 0 0 1040, +1056 -16"},
+			objects: []string{
+				"renderbuffer 1: 1024 GL_DEPTH24_STENCIL8 at 1 in frame 0",
+				"renderbuffer 2: 16 GL_RGBA8 at 2 in frame 0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tracker := NewTracker()
+			memory := NewMemoryTracker(tracker)
+
+			// as the ingester does, each call is shown to the memory tracker before the state tracker applies it
+			for _, frame := range parsers.ParseDump(test.dump).Frames {
+				for _, call := range frame.Calls {
+					memory.Call(frame.ID, call)
+					tracker.AddCall(call)
+				}
+
+				memory.EndFrame(frame.ID)
+			}
+
+			report := memory.Report()
+
+			timeline := []string{}
+
+			for _, frame := range report.Timeline {
+				timeline = append(timeline, fmt.Sprintf("frame %d: %d %d %d, +%d -%d", frame.Frame, frame.Textures,
+					frame.Buffers, frame.Renderbuffers, frame.Allocated, frame.Freed))
+
+				if frame.Total != frame.Textures+frame.Buffers+frame.Renderbuffers {
+					t.Errorf("the total of frame %d is %d", frame.Frame, frame.Total)
+				}
+			}
+
+			if !reflect.DeepEqual(timeline, test.timeline) {
+				t.Errorf("expected the timeline\n%s\ngot\n%s", strings.Join(test.timeline, "\n"), strings.Join(timeline, "\n"))
+			}
+
+			if report.Peak.Frame != test.peak {
+				t.Errorf("expected the peak to be in frame %d, got %d", test.peak, report.Peak.Frame)
+			}
+
+			objects := []string{}
+
+			for _, object := range report.Objects {
+				description := fmt.Sprintf("%s %s: %d", object.Kind, object.Name, object.Bytes)
+
+				if len(object.Format) > 0 {
+					description += " " + object.Format
+				}
+
+				objects = append(objects, fmt.Sprintf("%s at %s in frame %d", description, object.Call, object.Frame))
+			}
+
+			if !reflect.DeepEqual(objects, test.objects) {
+				t.Errorf("expected the objects\n%s\ngot\n%s", strings.Join(test.objects, "\n"), strings.Join(objects, "\n"))
+			}
+		})
+	}
+}
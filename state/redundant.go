@@ -0,0 +1,107 @@
+package state
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+)
+
+// Whether a call would set state to the value it already has on the context it is made on, such as binding the
+// texture already bound or enabling a capability already enabled. State the trace hasn't set yet is never redundant
+// to set, except for bindings, which start at zero. Ask before adding the call
+func (t *Tracker) Redundant(call *parsers.Call) bool {
+	s, ok := t.Contexts[call.Context]
+
+	if !ok {
+		return false
+	}
+
+	function := parsers.Vendorless(call.FunctionName)
+
+	switch {
+	case settings[function]:
+		value, ok := s.Settings[function]
+		return ok && value == joinArgs(call.Args)
+
+	case uniformSetter(function, "glUniform"):
+		return t.sameUniform(call, function, s.Program, 0)
+
+	case uniformSetter(function, "glProgramUniform"):
+		return t.sameUniform(call, function, arg(call, 0), 1)
+	}
+
+	switch function {
+	case "glUseProgram", "glUseProgramObject":
+		return s.Program == arg(call, 0)
+
+	case "glBindProgramPipeline":
+		return s.ProgramPipeline == arg(call, 0)
+
+	case "glBindVertexArray":
+		return s.VertexArray == arg(call, 0)
+
+	case "glBindBuffer":
+		return Bound(s.Buffers[arg(call, 0)]) == arg(call, 1)
+
+	// glBindBufferRange also sets the range, which isn't kept
+	case "glBindBufferBase":
+		return Bound(s.Buffers[fmt.Sprintf("%s[%s]", arg(call, 0), arg(call, 1))]) == arg(call, 2)
+
+	case "glActiveTexture":
+		return s.ActiveTexture == arg(call, 0)
+
+	case "glBindTexture":
+		return Bound(s.Textures[s.ActiveTexture][arg(call, 0)]) == arg(call, 1)
+
+	case "glBindSampler":
+		return Bound(s.Samplers["GL_TEXTURE"+arg(call, 0)]) == arg(call, 1)
+
+	case "glBindFramebuffer":
+		switch arg(call, 0) {
+		case "GL_DRAW_FRAMEBUFFER":
+			return s.DrawFramebuffer == arg(call, 1)
+		case "GL_READ_FRAMEBUFFER":
+			return s.ReadFramebuffer == arg(call, 1)
+		default:
+			return s.DrawFramebuffer == arg(call, 1) && s.ReadFramebuffer == arg(call, 1)
+		}
+
+	case "glBindRenderbuffer":
+		return s.Renderbuffer == arg(call, 1)
+
+	case "glViewport":
+		return s.Viewport != nil && fmt.Sprint(s.Viewport) == fmt.Sprint(integers(call.Args))
+
+	case "glScissor":
+		return s.Scissor != nil && fmt.Sprint(s.Scissor) == fmt.Sprint(integers(call.Args))
+
+	case "glEnable", "glDisable":
+		enabled, ok := s.Enables[arg(call, 0)]
+		return ok && enabled == (function == "glEnable")
+
+	case "glEnablei", "glDisablei":
+		enabled, ok := s.Enables[fmt.Sprintf("%s[%s]", arg(call, 0), arg(call, 1))]
+		return ok && enabled == (function == "glEnablei")
+	}
+
+	return false
+}
+
+// whether a uniform call writes the value the uniform already holds, with the same function
+func (t *Tracker) sameUniform(call *parsers.Call, function, program string, location int) bool {
+	if len(call.Args) <= location {
+		return false
+	}
+
+	uniform, ok := t.Uniforms[program][arg(call, location)]
+
+	return ok && uniform.Function == function && uniform.Value == uniformValue(call, location)
+}
+
+// The object bound to a binding point, where a binding point that has never been bound holds zero
+func Bound(name string) string {
+	if len(name) == 0 {
+		return "0"
+	}
+
+	return name
+}
@@ -0,0 +1,468 @@
+// Package state follows the GL state a trace sets up by walking its calls, so the bindings in effect at any call can
+// be worked out without replaying the trace
+package state
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the functions that set uniforms, glUniform{1,2,3,4}{f,i,ui,d}[v] and glUniformMatrix*, and their glProgramUniform
+// forms; glUniformBlockBinding and glUniformSubroutinesuiv share the prefix but set other state
+var uniformSetterPattern = regexp.MustCompile(`^gl(Program)?Uniform([1-4](f|i|ui|d)v?|Matrix[2-4](x[2-4])?(f|d)v)$`)
+
+// The bindings and fixed function state of one context. Object names are as the trace gives them, with "0" for
+// nothing bound. Capabilities only appear in Enables once they have been enabled or disabled
+type State struct {
+	Program         string `json:"program"`
+	ProgramPipeline string `json:"programPipeline"`
+	VertexArray     string `json:"vertexArray"`
+
+	// buffers bound to each target, and to each index of the indexed targets, e.g. GL_UNIFORM_BUFFER[0]
+	Buffers map[string]string `json:"buffers"`
+
+	// the textures bound to each target of each unit, and the samplers bound to each unit
+	ActiveTexture string                       `json:"activeTexture"`
+	Textures      map[string]map[string]string `json:"textures"`
+	Samplers      map[string]string            `json:"samplers"`
+
+	DrawFramebuffer string `json:"drawFramebuffer"`
+	ReadFramebuffer string `json:"readFramebuffer"`
+	Renderbuffer    string `json:"renderbuffer"`
+
+	Viewport []int64 `json:"viewport,omitempty"`
+	Scissor  []int64 `json:"scissor,omitempty"`
+
+	// capabilities, with an index for those enabled per draw buffer, e.g. GL_BLEND[1]
+	Enables map[string]bool `json:"enables"`
+
+	// the arguments of the last call to each function that sets blend, depth, stencil and rasteriser state
+	Settings map[string]string `json:"settings"`
+
+	// the element array buffer is part of the vertex array it was bound with
+	ElementArrayBuffers map[string]string `json:"elementArrayBuffers"`
+}
+
+// The last value written to a uniform of a program
+type Uniform struct {
+	Location string `json:"location"`
+	Name     string `json:"name,omitempty"`
+	Function string `json:"function"`
+	Value    string `json:"value"`
+	Call     string `json:"call"`
+}
+
+// A context's state, with the uniforms of its current program
+type Snapshot struct {
+	Context string `json:"context"`
+	*State
+	Uniforms []*Uniform `json:"uniforms"`
+}
+
+// Follows the state of every context in a trace. Programs, and the names of their uniforms, are shared between
+// contexts. The fields are exported so a tracker can be stored at the start of each frame and picked up from there
+type Tracker struct {
+	Contexts map[string]*State `json:"contexts"`
+
+	// uniform values and names by program, then location
+	Uniforms  map[string]map[string]*Uniform `json:"uniforms"`
+	Locations map[string]map[string]string   `json:"locations"`
+
+	// the target each texture was first bound to, which glBindTextureUnit binds it to again
+	TextureTargets map[string]string `json:"textureTargets"`
+}
+
+// functions whose arguments are kept as they are in Settings
+var settings = map[string]bool{
+	"glBlendFunc": true, "glBlendFuncSeparate": true, "glBlendFunci": true, "glBlendFuncSeparatei": true,
+	"glBlendEquation": true, "glBlendEquationSeparate": true, "glBlendEquationi": true,
+	"glBlendEquationSeparatei": true, "glBlendColor": true, "glDepthFunc": true, "glDepthMask": true,
+	"glDepthRange": true, "glDepthRangef": true, "glStencilFunc": true, "glStencilFuncSeparate": true,
+	"glStencilOp": true, "glStencilOpSeparate": true, "glStencilMask": true, "glStencilMaskSeparate": true,
+	"glColorMask": true, "glColorMaski": true, "glCullFace": true, "glFrontFace": true, "glPolygonMode": true,
+	"glPolygonOffset": true, "glLineWidth": true, "glPointSize": true, "glClearColor": true, "glClearDepth": true,
+	"glClearDepthf": true, "glClearStencil": true,
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		Contexts:       map[string]*State{},
+		Uniforms:       map[string]map[string]*Uniform{},
+		Locations:      map[string]map[string]string{},
+		TextureTargets: map[string]string{},
+	}
+}
+
+func newState() *State {
+	return &State{
+		Program:             "0",
+		ProgramPipeline:     "0",
+		VertexArray:         "0",
+		Buffers:             map[string]string{},
+		ActiveTexture:       "GL_TEXTURE0",
+		Textures:            map[string]map[string]string{},
+		Samplers:            map[string]string{},
+		DrawFramebuffer:     "0",
+		ReadFramebuffer:     "0",
+		Renderbuffer:        "0",
+		Enables:             map[string]bool{},
+		Settings:            map[string]string{},
+		ElementArrayBuffers: map[string]string{},
+	}
+}
+
+// Follow the calls of a frame
+func (t *Tracker) Add(frame *parsers.Frame) {
+	for _, call := range frame.Calls {
+		t.AddCall(call)
+	}
+}
+
+// Apply a call to the state of the context it was made on
+func (t *Tracker) AddCall(call *parsers.Call) {
+	s := t.context(call.Context)
+
+	function := parsers.Vendorless(call.FunctionName)
+
+	switch {
+	case settings[function]:
+		s.Settings[function] = joinArgs(call.Args)
+		return
+
+	case uniformSetter(function, "glUniform"):
+		t.uniform(call, function, s.Program, 0)
+		return
+
+	case uniformSetter(function, "glProgramUniform"):
+		t.uniform(call, function, arg(call, 0), 1)
+		return
+	}
+
+	switch function {
+	case "glUseProgram", "glUseProgramObject":
+		s.Program = arg(call, 0)
+
+	case "glBindProgramPipeline":
+		s.ProgramPipeline = arg(call, 0)
+
+	case "glLinkProgram":
+		// linking a program again resets its uniforms, and may move them
+		delete(t.Uniforms, arg(call, 0))
+		delete(t.Locations, arg(call, 0))
+
+	case "glGetUniformLocation":
+		if call.Return != nil && len(call.Args) >= 2 && call.Args[1].Value.String != nil {
+			program := arg(call, 0)
+
+			if t.Locations[program] == nil {
+				t.Locations[program] = map[string]string{}
+			}
+
+			t.Locations[program][call.Return.Text] = *call.Args[1].Value.String
+		}
+
+	case "glBindVertexArray":
+		s.VertexArray = arg(call, 0)
+		s.Buffers["GL_ELEMENT_ARRAY_BUFFER"] = s.ElementArrayBuffers[s.VertexArray]
+
+		if len(s.Buffers["GL_ELEMENT_ARRAY_BUFFER"]) == 0 {
+			s.Buffers["GL_ELEMENT_ARRAY_BUFFER"] = "0"
+		}
+
+	case "glBindBuffer":
+		target, buffer := arg(call, 0), arg(call, 1)
+
+		s.Buffers[target] = buffer
+
+		if target == "GL_ELEMENT_ARRAY_BUFFER" {
+			s.ElementArrayBuffers[s.VertexArray] = buffer
+		}
+
+	case "glBindBufferBase", "glBindBufferRange":
+		target, buffer := arg(call, 0), arg(call, 2)
+
+		s.Buffers[target] = buffer
+		s.Buffers[fmt.Sprintf("%s[%s]", target, arg(call, 1))] = buffer
+
+	case "glActiveTexture":
+		s.ActiveTexture = arg(call, 0)
+
+	case "glBindTexture":
+		target, texture := arg(call, 0), arg(call, 1)
+
+		s.bindTexture(s.ActiveTexture, target, texture)
+
+		if _, ok := t.TextureTargets[texture]; !ok && texture != "0" {
+			t.TextureTargets[texture] = target
+		}
+
+	case "glCreateTextures":
+		if len(call.Args) >= 3 {
+			for _, texture := range names(call.Args[2].Value) {
+				t.TextureTargets[texture] = arg(call, 0)
+			}
+		}
+
+	case "glBindTextureUnit":
+		texture := arg(call, 1)
+
+		if target, ok := t.TextureTargets[texture]; ok {
+			s.bindTexture("GL_TEXTURE"+arg(call, 0), target, texture)
+		}
+
+	case "glBindSampler":
+		s.Samplers["GL_TEXTURE"+arg(call, 0)] = arg(call, 1)
+
+	case "glBindFramebuffer":
+		switch arg(call, 0) {
+		case "GL_DRAW_FRAMEBUFFER":
+			s.DrawFramebuffer = arg(call, 1)
+		case "GL_READ_FRAMEBUFFER":
+			s.ReadFramebuffer = arg(call, 1)
+		default:
+			s.DrawFramebuffer, s.ReadFramebuffer = arg(call, 1), arg(call, 1)
+		}
+
+	case "glBindRenderbuffer":
+		s.Renderbuffer = arg(call, 1)
+
+	case "glViewport":
+		s.Viewport = integers(call.Args)
+
+	case "glScissor":
+		s.Scissor = integers(call.Args)
+
+	case "glEnable", "glDisable":
+		s.Enables[arg(call, 0)] = function == "glEnable"
+
+	case "glEnablei", "glDisablei":
+		s.Enables[fmt.Sprintf("%s[%s]", arg(call, 0), arg(call, 1))] = function == "glEnablei"
+
+	case "glDeleteBuffers":
+		s.unbind(call, func(buffer string) {
+			for target, bound := range s.Buffers {
+				if bound == buffer {
+					s.Buffers[target] = "0"
+				}
+			}
+
+			for array, bound := range s.ElementArrayBuffers {
+				if bound == buffer {
+					s.ElementArrayBuffers[array] = "0"
+				}
+			}
+		})
+
+	case "glDeleteTextures":
+		s.unbind(call, func(texture string) {
+			for _, targets := range s.Textures {
+				for target, bound := range targets {
+					if bound == texture {
+						targets[target] = "0"
+					}
+				}
+			}
+
+			delete(t.TextureTargets, texture)
+		})
+
+	case "glDeleteSamplers":
+		s.unbind(call, func(sampler string) {
+			for unit, bound := range s.Samplers {
+				if bound == sampler {
+					s.Samplers[unit] = "0"
+				}
+			}
+		})
+
+	case "glDeleteFramebuffers":
+		s.unbind(call, func(framebuffer string) {
+			if s.DrawFramebuffer == framebuffer {
+				s.DrawFramebuffer = "0"
+			}
+
+			if s.ReadFramebuffer == framebuffer {
+				s.ReadFramebuffer = "0"
+			}
+		})
+
+	case "glDeleteRenderbuffers":
+		s.unbind(call, func(renderbuffer string) {
+			if s.Renderbuffer == renderbuffer {
+				s.Renderbuffer = "0"
+			}
+		})
+
+	case "glDeleteVertexArrays":
+		s.unbind(call, func(array string) {
+			if s.VertexArray == array {
+				s.VertexArray = "0"
+				s.Buffers["GL_ELEMENT_ARRAY_BUFFER"] = s.ElementArrayBuffers["0"]
+			}
+
+			delete(s.ElementArrayBuffers, array)
+		})
+
+	case "glDeleteProgramPipelines":
+		s.unbind(call, func(pipeline string) {
+			if s.ProgramPipeline == pipeline {
+				s.ProgramPipeline = "0"
+			}
+		})
+	}
+}
+
+// The state of a context, with the uniforms of the program it is using. Contexts the trace never used have every
+// binding at its default
+func (t *Tracker) Snapshot(context string) *Snapshot {
+	s, ok := t.Contexts[context]
+
+	if !ok {
+		s = newState()
+	}
+
+	snapshot := &Snapshot{Context: context, State: s, Uniforms: []*Uniform{}}
+
+	for _, uniform := range t.Uniforms[s.Program] {
+		snapshot.Uniforms = append(snapshot.Uniforms, uniform)
+	}
+
+	sort.Slice(snapshot.Uniforms, func(i, j int) bool {
+		a, _ := strconv.Atoi(snapshot.Uniforms[i].Location)
+		b, _ := strconv.Atoi(snapshot.Uniforms[j].Location)
+
+		return a < b
+	})
+
+	return snapshot
+}
+
+// The state of a context as it stands, which changes as calls are added
+func (t *Tracker) State(context string) *State {
+	return t.context(context)
+}
+
+func (t *Tracker) context(context string) *State {
+	s, ok := t.Contexts[context]
+
+	if !ok {
+		s = newState()
+		t.Contexts[context] = s
+	}
+
+	return s
+}
+
+// record a write to a uniform, with the location at position location among the call's arguments
+func (t *Tracker) uniform(call *parsers.Call, function, program string, location int) {
+	if len(call.Args) <= location {
+		return
+	}
+
+	// writes to location -1 are silently ignored by GL
+	if n, ok := call.Args[location].Value.Integer(); ok && n < 0 {
+		return
+	}
+
+	uniform := &Uniform{
+		Location: arg(call, location),
+		Name:     t.Locations[program][arg(call, location)],
+		Function: function,
+		Value:    uniformValue(call, location),
+		Call:     call.ID,
+	}
+
+	if t.Uniforms[program] == nil {
+		t.Uniforms[program] = map[string]*Uniform{}
+	}
+
+	t.Uniforms[program][uniform.Location] = uniform
+}
+
+// whether a function sets uniforms, in the form with the given prefix
+func uniformSetter(function, prefix string) bool {
+	return strings.HasPrefix(function, prefix) && uniformSetterPattern.MatchString(function)
+}
+
+// the value a uniform call writes, from the arguments after the location, leaving out the count of array elements
+func uniformValue(call *parsers.Call, location int) string {
+	var values []*parsers.Argument
+
+	for _, value := range call.Args[location+1:] {
+		if value.Name != "count" {
+			values = append(values, value)
+		}
+	}
+
+	return joinArgs(values)
+}
+
+func (s *State) bindTexture(unit, target, texture string) {
+	if s.Textures[unit] == nil {
+		s.Textures[unit] = map[string]string{}
+	}
+
+	s.Textures[unit][target] = texture
+}
+
+// deleting an object that is bound to the context unbinds it; the names are the second argument of each glDelete call
+func (s *State) unbind(call *parsers.Call, unbind func(name string)) {
+	if len(call.Args) < 2 {
+		return
+	}
+
+	for _, name := range names(call.Args[1].Value) {
+		if name != "0" {
+			unbind(name)
+		}
+	}
+}
+
+// the text of an argument, or the empty string if the call has too few
+func arg(call *parsers.Call, i int) string {
+	if i >= len(call.Args) {
+		return ""
+	}
+
+	return call.Args[i].Value.Text
+}
+
+func joinArgs(args []*parsers.Argument) string {
+	texts := make([]string, len(args))
+
+	for i, arg := range args {
+		texts[i] = arg.Value.Text
+	}
+
+	return strings.Join(texts, ", ")
+}
+
+func integers(args []*parsers.Argument) []int64 {
+	var values []int64
+
+	for _, arg := range args {
+		n, _ := arg.Value.Integer()
+		values = append(values, n)
+	}
+
+	return values
+}
+
+// the object names in an array argument, such as the textures passed to glDeleteTextures
+func names(v *parsers.Value) []string {
+	if v == nil {
+		return nil
+	}
+
+	var found []string
+
+	for _, element := range v.Elements {
+		found = append(found, element.Text)
+	}
+
+	return found
+}
@@ -0,0 +1,252 @@
+package state
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTracker(t *testing.T) {
+	tests := []struct {
+		name    string
+		dump    string
+		context string
+
+		// the parts of the context's snapshot checked, as flatten names them
+		want map[string]string
+	}{
+		{
+			name: "buffers",
+			dump: `0 glUseProgram(program = 3)
+1 glBindVertexArray(array = 1)
+2 glBindBuffer(target = GL_ARRAY_BUFFER, buffer = 4)
+3 glBindBuffer(target = GL_ELEMENT_ARRAY_BUFFER, buffer = 5)
+4 glBindBufferBase(target = GL_UNIFORM_BUFFER, index = 0, buffer = 6)
+5 glBindVertexArray(array = 2)
+6 glBindBufferARB(target = GL_PIXEL_UNPACK_BUFFER, buffer = 7)`,
+			want: map[string]string{
+				"program":                        "3",
+				"vertex array":                   "2",
+				"buffer GL_ARRAY_BUFFER":         "4",
+				"buffer GL_UNIFORM_BUFFER":       "6",
+				"buffer GL_UNIFORM_BUFFER[0]":    "6",
+				"buffer GL_PIXEL_UNPACK_BUFFER":  "7",
+				"buffer GL_ELEMENT_ARRAY_BUFFER": "0",
+			},
+		},
+		{
+			// the element array buffer is restored with the vertex array it was bound with
+			name: "element array buffers",
+			dump: `0 glBindVertexArray(array = 1)
+1 glBindBuffer(target = GL_ELEMENT_ARRAY_BUFFER, buffer = 5)
+2 glBindVertexArray(array = 2)
+3 glBindVertexArray(array = 1)`,
+			want: map[string]string{
+				"vertex array":                   "1",
+				"buffer GL_ELEMENT_ARRAY_BUFFER": "5",
+			},
+		},
+		{
+			name: "textures and samplers",
+			dump: `0 glActiveTexture(texture = GL_TEXTURE1)
+1 glBindTexture(target = GL_TEXTURE_2D, texture = 7)
+2 glBindSampler(unit = 1, sampler = 2)
+3 glCreateTextures(target = GL_TEXTURE_CUBE_MAP, n = 1, textures = &8)
+4 glBindTextureUnit(unit = 3, texture = 8)
+5 glBindTextureUnit(unit = 2, texture = 7)
+6 glBindTextureUnit(unit = 4, texture = 9)`,
+			want: map[string]string{
+				"active texture":                          "GL_TEXTURE1",
+				"texture GL_TEXTURE1 GL_TEXTURE_2D":       "7",
+				"texture GL_TEXTURE2 GL_TEXTURE_2D":       "7",
+				"texture GL_TEXTURE3 GL_TEXTURE_CUBE_MAP": "8",
+				"texture GL_TEXTURE4 GL_TEXTURE_2D":       "",
+				"sampler GL_TEXTURE1":                     "2",
+			},
+		},
+		{
+			name: "framebuffers",
+			dump: `0 glBindFramebuffer(target = GL_READ_FRAMEBUFFER, framebuffer = 2)
+1 glBindFramebuffer(target = GL_DRAW_FRAMEBUFFER, framebuffer = 3)
+2 glBindRenderbuffer(target = GL_RENDERBUFFER, renderbuffer = 4)`,
+			want: map[string]string{
+				"read framebuffer": "2",
+				"draw framebuffer": "3",
+				"renderbuffer":     "4",
+			},
+		},
+		{
+			name: "both framebuffers",
+			dump: `0 glBindFramebuffer(target = GL_READ_FRAMEBUFFER, framebuffer = 2)
+1 glBindFramebuffer(target = GL_FRAMEBUFFER, framebuffer = 5)`,
+			want: map[string]string{
+				"read framebuffer": "5",
+				"draw framebuffer": "5",
+			},
+		},
+		{
+			name: "deleting unbinds",
+			dump: `0 glBindTexture(target = GL_TEXTURE_2D, texture = 7)
+1 glBindBuffer(target = GL_ARRAY_BUFFER, buffer = 4)
+2 glBindFramebuffer(target = GL_FRAMEBUFFER, framebuffer = 9)
+3 glBindSampler(unit = 0, sampler = 2)
+4 glBindVertexArray(array = 1)
+5 glDeleteTextures(n = 1, textures = &7)
+6 glDeleteBuffers(n = 2, buffers = {3, 4})
+7 glDeleteFramebuffers(n = 1, framebuffers = &9)
+8 glDeleteSamplers(count = 1, samplers = &2)
+9 glDeleteVertexArrays(n = 1, arrays = &1)`,
+			want: map[string]string{
+				"texture GL_TEXTURE0 GL_TEXTURE_2D": "0",
+				"buffer GL_ARRAY_BUFFER":            "0",
+				"draw framebuffer":                  "0",
+				"read framebuffer":                  "0",
+				"sampler GL_TEXTURE0":               "0",
+				"vertex array":                      "0",
+			},
+		},
+		{
+			name: "capabilities and settings",
+			dump: `0 glEnable(cap = GL_BLEND)
+1 glEnablei(target = GL_BLEND, index = 1)
+2 glDisable(cap = GL_BLEND)
+3 glEnable(cap = GL_DEPTH_TEST)
+4 glBlendFunc(sfactor = GL_SRC_ALPHA, dfactor = GL_ONE_MINUS_SRC_ALPHA)
+5 glViewport(x = 0, y = 0, width = 800, height = 600)
+6 glScissor(x = 10, y = 20, width = 30, height = 40)`,
+			want: map[string]string{
+				"enable GL_BLEND":      "false",
+				"enable GL_BLEND[1]":   "true",
+				"enable GL_DEPTH_TEST": "true",
+				"enable GL_CULL_FACE":  "",
+				"setting glBlendFunc":  "GL_SRC_ALPHA, GL_ONE_MINUS_SRC_ALPHA",
+				"viewport":             "[0 0 800 600]",
+				"scissor":              "[10 20 30 40]",
+			},
+		},
+		{
+			// writes to location -1 are ignored, and glUniformBlockBinding shares the prefix but sets no uniform
+			name: "uniforms",
+			dump: `0 glUseProgram(program = 3)
+1 glGetUniformLocation(program = 3, name = "color") = 2
+2 glUniform4f(location = 2, v0 = 1, v1 = 0, v2 = 0, v3 = 1)
+3 glUniform1fv(location = 4, count = 2, value = {0.5, 0.25})
+4 glUniform1i(location = -1, v0 = 3)
+5 glUniformBlockBinding(program = 3, uniformBlockIndex = 0, uniformBlockBinding = 1)
+6 glProgramUniform1i(program = 3, location = 5, v0 = 2)
+7 glProgramUniformMatrix2fv(program = 4, location = 0, count = 1, transpose = GL_FALSE, value = {1, 0, 0, 1})
+8 glUniform4f(location = 2, v0 = 0, v1 = 1, v2 = 0, v3 = 1)`,
+			want: map[string]string{
+				"uniforms":  "2 4 5",
+				"uniform 2": "color glUniform4f 0, 1, 0, 1 at 8",
+				"uniform 4": "glUniform1fv {0.5, 0.25} at 3",
+				"uniform 5": "glProgramUniform1i 2 at 6",
+			},
+		},
+		{
+			name: "relinking resets uniforms",
+			dump: `0 glUseProgram(program = 3)
+1 glUniform1i(location = 0, v0 = 3)
+2 glLinkProgram(program = 3)`,
+			want: map[string]string{
+				"uniforms": "",
+			},
+		},
+		{
+			name: "contexts",
+			dump: `0 glXMakeCurrent(dpy = 0x5581ac2f06b0, drawable = 62914562, ctx = 0x5581ac3d1e40) = True
+1 glUseProgram(program = 3)
+2 glXMakeCurrent(dpy = 0x5581ac2f06b0, drawable = 62914562, ctx = 0x5581ac3d2000) = True
+3 glUseProgram(program = 4)`,
+			context: "0x5581ac3d1e40",
+			want: map[string]string{
+				"program": "3",
+			},
+		},
+		{
+			name:    "unused contexts",
+			dump:    `0 glUseProgram(program = 3)`,
+			context: "0x5581ac3d1e40",
+			want: map[string]string{
+				"program":        "0",
+				"active texture": "GL_TEXTURE0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tracker := NewTracker()
+
+			for _, frame := range parsers.ParseDump(test.dump).Frames {
+				tracker.Add(frame)
+			}
+
+			got := flatten(tracker.Snapshot(test.context))
+
+			for key, want := range test.want {
+				if got[key] != want {
+					t.Errorf("expected %s to be <%s>, got <%s>", key, want, got[key])
+				}
+			}
+		})
+	}
+}
+
+// the parts of a snapshot by name, e.g. "buffer GL_ARRAY_BUFFER" or "texture GL_TEXTURE0 GL_TEXTURE_2D"
+func flatten(s *Snapshot) map[string]string {
+	flat := map[string]string{
+		"program":          s.Program,
+		"vertex array":     s.VertexArray,
+		"active texture":   s.ActiveTexture,
+		"draw framebuffer": s.DrawFramebuffer,
+		"read framebuffer": s.ReadFramebuffer,
+		"renderbuffer":     s.Renderbuffer,
+		"viewport":         fmt.Sprint(s.Viewport),
+		"scissor":          fmt.Sprint(s.Scissor),
+	}
+
+	for target, buffer := range s.Buffers {
+		flat["buffer "+target] = buffer
+	}
+
+	for unit, targets := range s.Textures {
+		for target, texture := range targets {
+			flat["texture "+unit+" "+target] = texture
+		}
+	}
+
+	for unit, sampler := range s.Samplers {
+		flat["sampler "+unit] = sampler
+	}
+
+	for capability, enabled := range s.Enables {
+		flat["enable "+capability] = fmt.Sprint(enabled)
+	}
+
+	for function, args := range s.Settings {
+		flat["setting "+function] = args
+	}
+
+	var locations []string
+
+	for _, uniform := range s.Uniforms {
+		locations = append(locations, uniform.Location)
+
+		description := []string{uniform.Function, uniform.Value, "at", uniform.Call}
+
+		if len(uniform.Name) > 0 {
+			description = append([]string{uniform.Name}, description...)
+		}
+
+		flat["uniform "+uniform.Location] = strings.Join(description, " ")
+	}
+
+	sort.Strings(locations)
+
+	flat["uniforms"] = strings.Join(locations, " ")
+
+	return flat
+}
@@ -0,0 +1,158 @@
+package tracefile
+
+import (
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"math"
+	"strconv"
+)
+
+// Convert a call into the same form a parsed `apitrace dump` produces, with the types taken from the trace rather
+// than guessed from the text
+func (c *Call) ParsedCall() *parsers.Call {
+	pc := new(parsers.Call)
+
+	pc.ID = strconv.FormatUint(c.Number, 10)
+	pc.Thread = int(c.Thread)
+	pc.FunctionName = c.Function
+	pc.ParamNames = []string{}
+	pc.ParamValues = []string{}
+	pc.Args = []*parsers.Argument{}
+
+	for _, arg := range c.Args {
+		value := arg.Value
+
+		// arguments the tracer never wrote are shown as unknown, as `apitrace dump` does
+		if value == nil {
+			value = unknown{}
+		}
+
+		pc.ParamNames = append(pc.ParamNames, arg.Name)
+		pc.ParamValues = append(pc.ParamValues, value.String())
+		pc.Args = append(pc.Args, &parsers.Argument{Name: arg.Name, Value: ParsedValue(value)})
+	}
+
+	if c.Return != nil {
+		pc.ReturnValue = c.Return.String()
+		pc.Return = ParsedValue(c.Return)
+	}
+
+	return pc
+}
+
+// Whether the call ends a frame, either by its name or because the tracer flagged it
+func (c *Call) EndsFrame() bool {
+	return c.Flags&FlagEndFrame != 0 || parsers.EndsFrame(c.Function)
+}
+
+type unknown struct{}
+
+func (unknown) String() string {
+	return "?"
+}
+
+// Convert a value into the typed form used in dumps
+func ParsedValue(value Value) *parsers.Value {
+	v := &parsers.Value{Text: value.String()}
+
+	switch t := value.(type) {
+	case Null:
+		v.Kind = parsers.NullValue
+
+	case Bool:
+		v.Kind = parsers.BoolValue
+		b := bool(t)
+		v.Bool = &b
+
+	case SInt:
+		v.Kind = parsers.IntValue
+		i := int64(t)
+		v.Int = &i
+
+	case UInt:
+		v.Kind = parsers.UintValue
+		u := uint64(t)
+		v.Uint = &u
+
+	case Float:
+		v.Kind = parsers.FloatValue
+
+		// widening the float32 directly would turn 0.2 into 0.20000000298023224
+		f, _ := strconv.ParseFloat(t.String(), 64)
+		setFloat(v, f)
+
+	case Double:
+		v.Kind = parsers.FloatValue
+		setFloat(v, float64(t))
+
+	case String:
+		v.Kind = parsers.StringValue
+		s := string(t)
+		v.String = &s
+
+	case WString:
+		v.Kind = parsers.StringValue
+		s := string(t)
+		v.String = &s
+
+	case *Blob:
+		v.Kind = parsers.BlobValue
+		size := int64(t.Size)
+		v.Size = &size
+
+	case *Enum:
+		v.Kind = parsers.EnumValue
+		v.Symbol = t.Name()
+		i := t.Value
+		v.Int = &i
+
+	case *Bitmask:
+		v.Kind = parsers.BitmaskValue
+		v.Symbols, _ = t.Names()
+		u := t.Value
+		v.Uint = &u
+
+	case Array:
+		v.Kind = parsers.ArrayValue
+		v.Elements = []*parsers.Value{}
+
+		for _, element := range t {
+			v.Elements = append(v.Elements, ParsedValue(element))
+		}
+
+	case *Struct:
+		v.Kind = parsers.StructValue
+
+		for i, member := range t.Members {
+			v.Members = append(v.Members, &parsers.Member{Name: t.Sig.MemberNames[i], Value: ParsedValue(member)})
+		}
+
+	case Pointer:
+		if t == 0 {
+			v.Kind = parsers.NullValue
+			break
+		}
+
+		v.Kind = parsers.PointerValue
+		u := uint64(t)
+		v.Uint = &u
+
+	case *Repr:
+		// keep the readable form, which is what the text shows
+		parsed := ParsedValue(t.Human)
+		parsed.Text = v.Text
+
+		return parsed
+
+	default:
+		v.Kind = parsers.UnknownValue
+	}
+
+	return v
+}
+
+// JSON has no representation for infinities and NaNs, so those are only kept in the text
+func setFloat(v *parsers.Value, f float64) {
+	if !math.IsInf(f, 0) && !math.IsNaN(f) {
+		v.Float = &f
+	}
+}
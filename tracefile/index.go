@@ -0,0 +1,194 @@
+package tracefile
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"io"
+)
+
+// The calls making up one frame of a trace, inclusive
+type FrameRange struct {
+	ID         int    `json:"id"`
+	FirstCall  uint64 `json:"firstCall"`
+	LastCall   uint64 `json:"lastCall"`
+	Calls      int    `json:"calls"`
+	Incomplete bool   `json:"incomplete"`
+}
+
+// Read every call in the trace, handing each frame to handle as soon as its end-of-frame call has been read. Calls
+// after the last end-of-frame call are handed on as a final, incomplete frame. Blob contents are skipped, so memory
+// stays bounded by the size of a frame. Returns the number of frames handled
+func StreamFrames(path string, handle parsers.FrameHandler) (int, error) {
+	r, err := Open(path)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer r.Close()
+
+	r.SkipBlobs = true
+
+	frame := new(parsers.Frame)
+
+	contexts := parsers.NewContextTracker()
+
+	for {
+		call, err := r.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return frame.ID, err
+		}
+
+		parsed := call.ParsedCall()
+		contexts.Tag(parsed)
+
+		frame.AddCall(parsed)
+
+		if call.EndsFrame() {
+			if err := handle(frame); err != nil {
+				return frame.ID, err
+			}
+
+			frame = &parsers.Frame{ID: frame.ID + 1}
+		}
+	}
+
+	if len(frame.Calls) == 0 {
+		return frame.ID, nil
+	}
+
+	frame.Incomplete = true
+
+	return frame.ID + 1, handle(frame)
+}
+
+// List the frames in a trace along with the calls each one spans
+func IndexFrames(path string) ([]*FrameRange, error) {
+	r, err := Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+
+	r.SkipBlobs = true
+
+	frames := []*FrameRange{}
+
+	var current *FrameRange
+
+	for {
+		call, err := r.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return frames, err
+		}
+
+		if current == nil {
+			current = &FrameRange{ID: len(frames), FirstCall: call.Number}
+		}
+
+		if call.Number < current.FirstCall {
+			current.FirstCall = call.Number
+		}
+
+		if call.Number > current.LastCall {
+			current.LastCall = call.Number
+		}
+
+		current.Calls++
+
+		if call.EndsFrame() {
+			frames = append(frames, current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		current.Incomplete = true
+		frames = append(frames, current)
+	}
+
+	return frames, nil
+}
+
+// Read the trace up to the given call and return it, blobs included
+func FindCall(path string, number uint64) (*Call, error) {
+	r, err := Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+
+	for {
+		call, err := r.Next()
+
+		if err == io.EOF {
+			return nil, fmt.Errorf("call %d is not in %s", number, path)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if call.Number == number {
+			return call, nil
+		}
+	}
+}
+
+// Find a blob passed to a call, by argument name or position, searching inside arrays and structs for the first
+// blob when the argument holds more than one value
+func FindBlob(call *Call, arg string) (*Blob, error) {
+	for i, a := range call.Args {
+		if a.Name != arg && fmt.Sprintf("%d", i) != arg {
+			continue
+		}
+
+		if blob := firstBlob(a.Value); blob != nil {
+			return blob, nil
+		}
+
+		return nil, fmt.Errorf("argument <%s> of call %d is not a blob", arg, call.Number)
+	}
+
+	return nil, fmt.Errorf("call %d has no argument <%s>", call.Number, arg)
+}
+
+func firstBlob(value Value) *Blob {
+	switch t := value.(type) {
+	case *Blob:
+		return t
+
+	case Array:
+		for _, element := range t {
+			if blob := firstBlob(element); blob != nil {
+				return blob
+			}
+		}
+
+	case *Struct:
+		for _, member := range t.Members {
+			if blob := firstBlob(member); blob != nil {
+				return blob
+			}
+		}
+
+	case *Repr:
+		return firstBlob(t.Machine)
+	}
+
+	return nil
+}
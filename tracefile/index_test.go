@@ -0,0 +1,234 @@
+package tracefile
+
+import (
+	"fmt"
+	"github.com/fergloragain/apitrace-remote/parsers"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Frames end on a swap, by name, or on a call the tracer flagged as ending one, and whatever follows the last of them
+// is an incomplete frame
+func TestStreamFrames(t *testing.T) {
+	tests := []struct {
+		trace      string
+		frames     [][]string
+		incomplete []bool
+	}{
+		{
+			trace:      "snappy.trace",
+			frames:     [][]string{{"0", "1", "2", "3", "4", "5"}, {"6", "7"}, {"9", "8", "11", "10"}},
+			incomplete: []bool{false, false, true},
+		},
+		{
+			trace:      "gzip.trace",
+			frames:     [][]string{{"0", "1", "2", "3", "4", "5"}, {"6", "7"}, {"9", "8", "11", "10"}},
+			incomplete: []bool{false, false, true},
+		},
+		{
+			trace:      "truncated.trace",
+			frames:     [][]string{{"0", "1", "2", "3", "4", "5"}, {"6"}},
+			incomplete: []bool{false, true},
+		},
+		{
+			trace:      "v2.trace",
+			frames:     [][]string{{"0", "1", "2"}},
+			incomplete: []bool{false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.trace, func(t *testing.T) {
+			frames := [][]string{}
+			incomplete := []bool{}
+
+			count, err := StreamFrames(filepath.Join("testdata", test.trace), func(frame *parsers.Frame) error {
+				ids := []string{}
+
+				for _, call := range frame.Calls {
+					ids = append(ids, call.ID)
+				}
+
+				frames = append(frames, ids)
+				incomplete = append(incomplete, frame.Incomplete)
+
+				return nil
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if count != len(test.frames) {
+				t.Errorf("expected %d frames to be counted, got %d", len(test.frames), count)
+			}
+
+			if !reflect.DeepEqual(frames, test.frames) || !reflect.DeepEqual(incomplete, test.incomplete) {
+				t.Errorf("expected the frames %v, incomplete %v, got %v, incomplete %v", test.frames, test.incomplete,
+					frames, incomplete)
+			}
+		})
+	}
+}
+
+func TestIndexFrames(t *testing.T) {
+	tests := []struct {
+		trace  string
+		frames []*FrameRange
+	}{
+		{
+			trace: "snappy.trace",
+			frames: []*FrameRange{
+				{ID: 0, FirstCall: 0, LastCall: 5, Calls: 6},
+				{ID: 1, FirstCall: 6, LastCall: 7, Calls: 2},
+				{ID: 2, FirstCall: 8, LastCall: 11, Calls: 4, Incomplete: true},
+			},
+		},
+		{
+			trace: "truncated.trace",
+			frames: []*FrameRange{
+				{ID: 0, FirstCall: 0, LastCall: 5, Calls: 6},
+				{ID: 1, FirstCall: 6, LastCall: 6, Calls: 1, Incomplete: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.trace, func(t *testing.T) {
+			frames, err := IndexFrames(filepath.Join("testdata", test.trace))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(frames, test.frames) {
+				t.Errorf("expected the frames")
+
+				for _, frame := range test.frames {
+					t.Errorf("\t%+v", *frame)
+				}
+
+				t.Errorf("got")
+
+				for _, frame := range frames {
+					t.Errorf("\t%+v", *frame)
+				}
+			}
+		})
+	}
+}
+
+func TestFindCall(t *testing.T) {
+	tests := []struct {
+		trace  string
+		number uint64
+		call   string
+		err    string
+	}{
+		{trace: "snappy.trace", number: 0, call: version6Calls[0]},
+		{trace: "snappy.trace", number: 8, call: version6Calls[9]},
+		{trace: "snappy.trace", number: 10, call: version6Calls[11]},
+		{trace: "gzip.trace", number: 7, call: version6Calls[7]},
+		{trace: "truncated.trace", number: 7, err: "call 7 is not in testdata/truncated.trace"},
+		{trace: "snappy.trace", number: 12, err: "call 12 is not in testdata/snappy.trace"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s call %d", test.trace, test.number), func(t *testing.T) {
+			call, err := FindCall(filepath.Join("testdata", test.trace), test.number)
+
+			if len(test.err) > 0 {
+				if err == nil || err.Error() != test.err {
+					t.Errorf("expected the error <%s>, got %v", test.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if describe(call) != test.call {
+				t.Errorf("expected the call\n%s\ngot\n%s", test.call, describe(call))
+			}
+		})
+	}
+}
+
+func TestFindBlob(t *testing.T) {
+	tests := []struct {
+		name   string
+		number uint64
+		arg    string
+		data   []byte
+		err    string
+	}{
+		{
+			name:   "by name",
+			number: 3,
+			arg:    "data",
+			data:   []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		},
+		{
+			name:   "by position",
+			number: 3,
+			arg:    "2",
+			data:   []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		},
+		{
+			name:   "first in an array",
+			number: 6,
+			arg:    "indices",
+			data:   []byte{0, 0, 1, 0, 2, 0},
+		},
+		{
+			name:   "not a blob",
+			number: 3,
+			arg:    "size",
+			err:    "argument <size> of call 3 is not a blob",
+		},
+		{
+			name:   "array without blobs",
+			number: 6,
+			arg:    "count",
+			err:    "argument <count> of call 6 is not a blob",
+		},
+		{
+			name:   "missing argument",
+			number: 3,
+			arg:    "pixels",
+			err:    "call 3 has no argument <pixels>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			call, err := FindCall(filepath.Join("testdata", "snappy.trace"), test.number)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			blob, err := FindBlob(call, test.arg)
+
+			if len(test.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("expected the error <%s>, got %v", test.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(blob.Data, test.data) {
+				t.Errorf("expected the blob %v, got %v", test.data, blob.Data)
+			}
+		})
+	}
+}
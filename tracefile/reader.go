@@ -0,0 +1,934 @@
+// Package tracefile reads apitrace's binary .trace files directly, so calls can be listed, looked up and have their
+// blobs extracted without running `apitrace dump`
+package tracefile
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"github.com/golang/snappy"
+	"io"
+	"math"
+	"os"
+	"sort"
+)
+
+// the newest version of the format this reader understands
+const maxVersion = 6
+
+const (
+	eventEnter = 0
+	eventLeave = 1
+)
+
+const (
+	detailEnd       = 0
+	detailArg       = 1
+	detailReturn    = 2
+	detailThread    = 3
+	detailBacktrace = 4
+	detailFlags     = 5
+)
+
+const (
+	typeNull    = 0
+	typeFalse   = 1
+	typeTrue    = 2
+	typeSInt    = 3
+	typeUInt    = 4
+	typeFloat   = 5
+	typeDouble  = 6
+	typeString  = 7
+	typeBlob    = 8
+	typeEnum    = 9
+	typeBitmask = 10
+	typeArray   = 11
+	typeStruct  = 12
+	typeOpaque  = 13
+	typeRepr    = 14
+	typeWString = 15
+)
+
+const (
+	backtraceEnd      = 0
+	backtraceModule   = 1
+	backtraceFunction = 2
+	backtraceFilename = 3
+	backtraceLine     = 4
+	backtraceOffset   = 5
+)
+
+// Reads the calls in a trace one at a time. Signatures are only written the first time they are used, so a Reader
+// has to start at the beginning of the trace and keeps every signature it has seen
+type Reader struct {
+	stream *bufio.Reader
+	closer io.Closer
+
+	// Discard blob contents as they are read, keeping only their size
+	SkipBlobs bool
+
+	Version         uint64
+	SemanticVersion uint64
+	Properties      map[string]string
+
+	nextCall uint64
+	pending  map[uint64]*Call
+	ended    bool
+
+	functions map[uint64]*functionSig
+	enums     map[uint64]*EnumSig
+	bitmasks  map[uint64]*BitmaskSig
+	structs   map[uint64]*StructSig
+	frames    map[uint64]*StackFrame
+}
+
+type functionSig struct {
+	name     string
+	argNames []string
+}
+
+// Open a trace file written by `apitrace trace`, which is either snappy compressed or, from newer tools, gzipped
+func Open(path string) (*Reader, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := NewReader(file)
+
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	r.closer = file
+
+	return r, nil
+}
+
+// Read a trace from a stream, working out how it was compressed from its first bytes
+func NewReader(compressed io.Reader) (*Reader, error) {
+	buffered := bufio.NewReader(compressed)
+
+	magic, err := buffered.Peek(2)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read the trace header: %s", err.Error())
+	}
+
+	var decompressed io.Reader
+
+	switch {
+	case magic[0] == 'a' && magic[1] == 't':
+		buffered.Discard(2)
+		decompressed = &snappyReader{source: buffered}
+
+	case magic[0] == 0x1f && magic[1] == 0x8b:
+		decompressed, err = gzip.NewReader(buffered)
+
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown trace compression <0x%02x%02x>", magic[0], magic[1])
+	}
+
+	r := &Reader{
+		stream:     bufio.NewReaderSize(decompressed, 64*1024),
+		Properties: map[string]string{},
+		pending:    map[uint64]*Call{},
+		functions:  map[uint64]*functionSig{},
+		enums:      map[uint64]*EnumSig{},
+		bitmasks:   map[uint64]*BitmaskSig{},
+		structs:    map[uint64]*StructSig{},
+		frames:     map[uint64]*StackFrame{},
+	}
+
+	if err := r.header(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer.Close()
+}
+
+func (r *Reader) header() error {
+	var err error
+
+	r.Version, err = r.uint()
+
+	if err != nil {
+		return fmt.Errorf("could not read the trace version: %s", err.Error())
+	}
+
+	if r.Version > maxVersion {
+		return fmt.Errorf("unsupported trace version %d, expected at most %d", r.Version, maxVersion)
+	}
+
+	r.SemanticVersion = r.Version
+
+	if r.Version < 6 {
+		return nil
+	}
+
+	r.SemanticVersion, err = r.uint()
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		name, err := r.string()
+
+		if err != nil {
+			return err
+		}
+
+		if len(name) == 0 {
+			return nil
+		}
+
+		value, err := r.string()
+
+		if err != nil {
+			return err
+		}
+
+		r.Properties[name] = value
+	}
+}
+
+// Return the next call to finish, or io.EOF once the trace has been read. Calls are returned in the order they
+// returned in, as `apitrace dump` lists them; calls still running when the trace ended come last, in the order they
+// were made, marked Incomplete
+func (r *Reader) Next() (*Call, error) {
+	for !r.ended {
+		event, err := r.stream.ReadByte()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		var call *Call
+
+		switch event {
+		case eventEnter:
+			err = r.enter()
+
+		case eventLeave:
+			call, err = r.leave()
+
+		default:
+			return nil, fmt.Errorf("unknown event <%d>", event)
+		}
+
+		// a trace cut short by the tracer being killed ends partway through an event, which is treated as the end
+		// of the trace
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if call != nil {
+			return call, nil
+		}
+	}
+
+	r.ended = true
+
+	return r.nextIncomplete()
+}
+
+func (r *Reader) nextIncomplete() (*Call, error) {
+	if len(r.pending) == 0 {
+		return nil, io.EOF
+	}
+
+	numbers := make([]uint64, 0, len(r.pending))
+
+	for number := range r.pending {
+		numbers = append(numbers, number)
+	}
+
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	call := r.pending[numbers[0]]
+	delete(r.pending, numbers[0])
+
+	call.Incomplete = true
+
+	return call, nil
+}
+
+func (r *Reader) enter() error {
+	var thread uint64
+
+	if r.Version >= 4 {
+		var err error
+
+		thread, err = r.uint()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	sig, err := r.functionSig()
+
+	if err != nil {
+		return err
+	}
+
+	call := &Call{
+		Number:   r.nextCall,
+		Thread:   thread,
+		Function: sig.name,
+		Args:     make([]*Arg, len(sig.argNames)),
+	}
+
+	for i, name := range sig.argNames {
+		call.Args[i] = &Arg{Name: name}
+	}
+
+	r.nextCall++
+
+	// the call is only added once its details have been read, so a truncated enter event is dropped
+	if err := r.details(call); err != nil {
+		return err
+	}
+
+	r.pending[call.Number] = call
+
+	return nil
+}
+
+func (r *Reader) leave() (*Call, error) {
+	number, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	call, ok := r.pending[number]
+
+	if !ok {
+		// the details still have to be read to get to the next event
+		call = &Call{Number: number}
+
+		if err := r.details(call); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	if err := r.details(call); err != nil {
+		return nil, err
+	}
+
+	delete(r.pending, number)
+
+	return call, nil
+}
+
+func (r *Reader) details(call *Call) error {
+	for {
+		detail, err := r.stream.ReadByte()
+
+		if err != nil {
+			return err
+		}
+
+		switch detail {
+		case detailEnd:
+			return nil
+
+		case detailArg:
+			index, err := r.uint()
+
+			if err != nil {
+				return err
+			}
+
+			value, err := r.value()
+
+			if err != nil {
+				return err
+			}
+
+			for uint64(len(call.Args)) <= index {
+				call.Args = append(call.Args, &Arg{})
+			}
+
+			call.Args[index].Value = value
+
+		case detailReturn:
+			call.Return, err = r.value()
+
+			if err != nil {
+				return err
+			}
+
+		case detailThread:
+			call.Thread, err = r.uint()
+
+			if err != nil {
+				return err
+			}
+
+		case detailBacktrace:
+			call.Backtrace, err = r.backtrace()
+
+			if err != nil {
+				return err
+			}
+
+		case detailFlags:
+			flags, err := r.uint()
+
+			if err != nil {
+				return err
+			}
+
+			call.Flags |= flags
+
+		default:
+			return fmt.Errorf("call %d has an unknown detail <%d>", call.Number, detail)
+		}
+	}
+}
+
+func (r *Reader) functionSig() (*functionSig, error) {
+	id, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sig, ok := r.functions[id]; ok {
+		return sig, nil
+	}
+
+	sig := new(functionSig)
+
+	sig.name, err = r.string()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sig.argNames, err = r.strings()
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.functions[id] = sig
+
+	return sig, nil
+}
+
+func (r *Reader) value() (Value, error) {
+	kind, err := r.stream.ReadByte()
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case typeNull:
+		return Null{}, nil
+
+	case typeFalse:
+		return Bool(false), nil
+
+	case typeTrue:
+		return Bool(true), nil
+
+	case typeSInt:
+		u, err := r.uint()
+		return SInt(-int64(u)), err
+
+	case typeUInt:
+		u, err := r.uint()
+		return UInt(u), err
+
+	case typeFloat:
+		var bits uint32
+		err := binary.Read(r.stream, binary.LittleEndian, &bits)
+		return Float(math.Float32frombits(bits)), err
+
+	case typeDouble:
+		var bits uint64
+		err := binary.Read(r.stream, binary.LittleEndian, &bits)
+		return Double(math.Float64frombits(bits)), err
+
+	case typeString:
+		s, err := r.string()
+		return String(s), err
+
+	case typeWString:
+		return r.wstring()
+
+	case typeBlob:
+		return r.blob()
+
+	case typeEnum:
+		sig, err := r.enumSig()
+
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := r.sint()
+
+		return &Enum{sig, value}, err
+
+	case typeBitmask:
+		sig, err := r.bitmaskSig()
+
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := r.uint()
+
+		return &Bitmask{sig, value}, err
+
+	case typeArray:
+		count, err := r.uint()
+
+		if err != nil {
+			return nil, err
+		}
+
+		array := make(Array, 0, count)
+
+		for i := uint64(0); i < count; i++ {
+			element, err := r.value()
+
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, element)
+		}
+
+		return array, nil
+
+	case typeStruct:
+		sig, err := r.structSig()
+
+		if err != nil {
+			return nil, err
+		}
+
+		s := &Struct{Sig: sig}
+
+		for range sig.MemberNames {
+			member, err := r.value()
+
+			if err != nil {
+				return nil, err
+			}
+
+			s.Members = append(s.Members, member)
+		}
+
+		return s, nil
+
+	case typeOpaque:
+		u, err := r.uint()
+		return Pointer(u), err
+
+	case typeRepr:
+		human, err := r.value()
+
+		if err != nil {
+			return nil, err
+		}
+
+		machine, err := r.value()
+
+		return &Repr{human, machine}, err
+
+	default:
+		return nil, fmt.Errorf("unknown value type <%d>", kind)
+	}
+}
+
+func (r *Reader) blob() (*Blob, error) {
+	size, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	blob := &Blob{Size: size}
+
+	if r.SkipBlobs {
+		_, err = r.stream.Discard(int(size))
+		return blob, err
+	}
+
+	blob.Data = make([]byte, size)
+
+	_, err = io.ReadFull(r.stream, blob.Data)
+
+	return blob, err
+}
+
+func (r *Reader) enumSig() (*EnumSig, error) {
+	id, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sig, ok := r.enums[id]; ok {
+		return sig, nil
+	}
+
+	sig := &EnumSig{ID: id}
+
+	// before version 3 each enum signature held a single name
+	count := uint64(1)
+
+	if r.Version >= 3 {
+		count, err = r.uint()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := uint64(0); i < count; i++ {
+		name, err := r.string()
+
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := r.sint()
+
+		if err != nil {
+			return nil, err
+		}
+
+		sig.Values = append(sig.Values, EnumName{name, value})
+	}
+
+	r.enums[id] = sig
+
+	return sig, nil
+}
+
+func (r *Reader) bitmaskSig() (*BitmaskSig, error) {
+	id, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sig, ok := r.bitmasks[id]; ok {
+		return sig, nil
+	}
+
+	sig := &BitmaskSig{ID: id}
+
+	count, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		name, err := r.string()
+
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := r.uint()
+
+		if err != nil {
+			return nil, err
+		}
+
+		sig.Flags = append(sig.Flags, BitmaskFlag{name, value})
+	}
+
+	r.bitmasks[id] = sig
+
+	return sig, nil
+}
+
+func (r *Reader) structSig() (*StructSig, error) {
+	id, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sig, ok := r.structs[id]; ok {
+		return sig, nil
+	}
+
+	sig := &StructSig{ID: id}
+
+	sig.Name, err = r.string()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sig.MemberNames, err = r.strings()
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.structs[id] = sig
+
+	return sig, nil
+}
+
+func (r *Reader) backtrace() ([]*StackFrame, error) {
+	count, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var backtrace []*StackFrame
+
+	for i := uint64(0); i < count; i++ {
+		id, err := r.uint()
+
+		if err != nil {
+			return nil, err
+		}
+
+		frame, ok := r.frames[id]
+
+		if !ok {
+			frame, err = r.stackFrame()
+
+			if err != nil {
+				return nil, err
+			}
+
+			r.frames[id] = frame
+		}
+
+		backtrace = append(backtrace, frame)
+	}
+
+	return backtrace, nil
+}
+
+func (r *Reader) stackFrame() (*StackFrame, error) {
+	frame := new(StackFrame)
+
+	for {
+		detail, err := r.stream.ReadByte()
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch detail {
+		case backtraceEnd:
+			return frame, nil
+
+		case backtraceModule:
+			frame.Module, err = r.string()
+
+		case backtraceFunction:
+			frame.Function, err = r.string()
+
+		case backtraceFilename:
+			frame.Filename, err = r.string()
+
+		case backtraceLine:
+			frame.Line, err = r.uint()
+
+		case backtraceOffset:
+			frame.Offset, err = r.uint()
+
+		default:
+			return nil, fmt.Errorf("unknown stack frame detail <%d>", detail)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// integers are written 7 bits at a time, least significant first, with the top bit set on every byte but the last
+func (r *Reader) uint() (uint64, error) {
+	var value uint64
+	var shift uint
+
+	for {
+		b, err := r.stream.ReadByte()
+
+		if err != nil {
+			return 0, err
+		}
+
+		value |= uint64(b&0x7f) << shift
+
+		if b&0x80 == 0 {
+			return value, nil
+		}
+
+		shift += 7
+
+		if shift >= 64 {
+			return 0, fmt.Errorf("integer too long")
+		}
+	}
+}
+
+// enum values carry their own sign, as a type byte ahead of the magnitude
+func (r *Reader) sint() (int64, error) {
+	kind, err := r.stream.ReadByte()
+
+	if err != nil {
+		return 0, err
+	}
+
+	u, err := r.uint()
+
+	if err != nil {
+		return 0, err
+	}
+
+	switch kind {
+	case typeSInt:
+		return -int64(u), nil
+	case typeUInt:
+		return int64(u), nil
+	default:
+		return 0, fmt.Errorf("expected a signed integer, found type <%d>", kind)
+	}
+}
+
+func (r *Reader) string() (string, error) {
+	length, err := r.uint()
+
+	if err != nil {
+		return "", err
+	}
+
+	b := make([]byte, length)
+
+	_, err = io.ReadFull(r.stream, b)
+
+	return string(b), err
+}
+
+func (r *Reader) strings() ([]string, error) {
+	count, err := r.uint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	strs := make([]string, 0, count)
+
+	for i := uint64(0); i < count; i++ {
+		s, err := r.string()
+
+		if err != nil {
+			return nil, err
+		}
+
+		strs = append(strs, s)
+	}
+
+	return strs, nil
+}
+
+// wide strings are written as one integer per character
+func (r *Reader) wstring() (WString, error) {
+	length, err := r.uint()
+
+	if err != nil {
+		return "", err
+	}
+
+	runes := make([]rune, 0, length)
+
+	for i := uint64(0); i < length; i++ {
+		c, err := r.uint()
+
+		if err != nil {
+			return "", err
+		}
+
+		runes = append(runes, rune(c))
+	}
+
+	return WString(runes), nil
+}
+
+// apitrace's snappy container is the "at" magic followed by chunks, each a 4 byte little endian length and a raw
+// snappy block of that length
+type snappyReader struct {
+	source *bufio.Reader
+	chunk  []byte
+}
+
+func (s *snappyReader) Read(p []byte) (int, error) {
+	for len(s.chunk) == 0 {
+		var length uint32
+
+		if err := binary.Read(s.source, binary.LittleEndian, &length); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return 0, io.EOF
+			}
+
+			return 0, err
+		}
+
+		compressed := make([]byte, length)
+
+		if _, err := io.ReadFull(s.source, compressed); err != nil {
+			// the tracer was killed while writing this chunk
+			return 0, io.EOF
+		}
+
+		chunk, err := snappy.Decode(nil, compressed)
+
+		if err != nil {
+			return 0, fmt.Errorf("could not decompress a trace chunk: %s", err.Error())
+		}
+
+		s.chunk = chunk
+	}
+
+	n := copy(p, s.chunk)
+	s.chunk = s.chunk[n:]
+
+	return n, nil
+}
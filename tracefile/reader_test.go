@@ -0,0 +1,232 @@
+package tracefile
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The calls of the version 6 trace written by testdata/gen.go, in the order Next returns them
+var version6Calls = []string{
+	"0 glClearColor(red = 0.25, green = 0.5, blue = 0.75, alpha = 1)",
+	"1 glEnable(cap = GL_BLEND)",
+	"2 glEnable(cap = GL_DEPTH_TEST)",
+	"3 glBufferData(target = GL_ARRAY_BUFFER, size = 16, data = blob(16), usage = GL_STATIC_DRAW)",
+	"4 glClear(mask = GL_COLOR_BUFFER_BIT | GL_DEPTH_BUFFER_BIT)",
+	"5 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)",
+	"6 glMultiDrawElements(mode = GL_TRIANGLES, count = {3, 3}, type = GL_UNSIGNED_SHORT, " +
+		"indices = {blob(6), blob(6)}, drawcount = 2)",
+	"7 glFlush() flags 32",
+	"9 @2 glGetError() = GL_NO_ERROR",
+	"8 @1 glGetError() = GL_NO_ERROR",
+	"11 glDrawArrays(mode = GL_TRIANGLES, first = 0, count = 3)",
+	"10 glFinish() incomplete",
+}
+
+func TestReaderNext(t *testing.T) {
+	tests := []struct {
+		trace      string
+		version    uint64
+		properties map[string]string
+		calls      []string
+	}{
+		{
+			trace:      "snappy.trace",
+			version:    6,
+			properties: map[string]string{"process.name": "triangle", "gl.vendor": "Mesa"},
+			calls:      version6Calls,
+		},
+		{
+			trace:      "gzip.trace",
+			version:    6,
+			properties: map[string]string{"process.name": "triangle", "gl.vendor": "Mesa"},
+			calls:      version6Calls,
+		},
+		{
+			// cut off partway through the event returning from call 6, which is then still running
+			trace:      "truncated.trace",
+			version:    6,
+			properties: map[string]string{"process.name": "triangle", "gl.vendor": "Mesa"},
+			calls: append(append([]string{}, version6Calls[:6]...),
+				"6 glMultiDrawElements(mode = GL_TRIANGLES, count = {3, 3}, type = GL_UNSIGNED_SHORT, "+
+					"indices = {blob(6), blob(6)}, drawcount = 2) incomplete"),
+		},
+		{
+			trace:      "v2.trace",
+			version:    2,
+			properties: map[string]string{},
+			calls: []string{
+				"0 glEnable(cap = GL_BLEND)",
+				"1 glEnable(cap = GL_BLEND)",
+				"2 glXSwapBuffers(dpy = 0x5581ac2f06b0, drawable = 62914562)",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.trace, func(t *testing.T) {
+			r, err := Open(filepath.Join("testdata", test.trace))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer r.Close()
+
+			if r.Version != test.version {
+				t.Errorf("expected version %d, got %d", test.version, r.Version)
+			}
+
+			if !reflect.DeepEqual(r.Properties, test.properties) {
+				t.Errorf("expected properties %v, got %v", test.properties, r.Properties)
+			}
+
+			calls := readAll(t, r)
+
+			if !reflect.DeepEqual(calls, test.calls) {
+				t.Errorf("expected the calls\n%s\ngot\n%s", strings.Join(test.calls, "\n"), strings.Join(calls, "\n"))
+			}
+		})
+	}
+}
+
+// From version 3, an enum signature lists every name of the parameter's type rather than the one value passed
+func TestReaderEnumSignatures(t *testing.T) {
+	tests := []struct {
+		trace string
+		call  uint64
+		names []EnumName
+	}{
+		{"snappy.trace", 1, []EnumName{{"GL_BLEND", 0x0BE2}, {"GL_DEPTH_TEST", 0x0B71}}},
+		{"snappy.trace", 2, []EnumName{{"GL_BLEND", 0x0BE2}, {"GL_DEPTH_TEST", 0x0B71}}},
+		{"v2.trace", 0, []EnumName{{"GL_BLEND", 0x0BE2}}},
+		{"v2.trace", 1, []EnumName{{"GL_BLEND", 0x0BE2}}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s call %d", test.trace, test.call), func(t *testing.T) {
+			call, err := FindCall(filepath.Join("testdata", test.trace), test.call)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			enum, ok := call.Args[0].Value.(*Enum)
+
+			if !ok {
+				t.Fatalf("expected an enum, got %T", call.Args[0].Value)
+			}
+
+			if !reflect.DeepEqual(enum.Sig.Values, test.names) {
+				t.Errorf("expected the names %v, got %v", test.names, enum.Sig.Values)
+			}
+		})
+	}
+}
+
+func TestReaderSkipBlobs(t *testing.T) {
+	tests := []struct {
+		skip bool
+		data []byte
+	}{
+		{false, []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}},
+		{true, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("skip %t", test.skip), func(t *testing.T) {
+			r, err := Open(filepath.Join("testdata", "snappy.trace"))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer r.Close()
+
+			r.SkipBlobs = test.skip
+
+			for {
+				call, err := r.Next()
+
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if call.Function != "glBufferData" {
+					continue
+				}
+
+				blob := call.Args[2].Value.(*Blob)
+
+				if blob.Size != 16 || !reflect.DeepEqual(blob.Data, test.data) {
+					t.Errorf("expected a blob of 16 bytes holding %v, got %d bytes holding %v", test.data, blob.Size, blob.Data)
+				}
+
+				return
+			}
+		})
+	}
+}
+
+func TestReaderUnknownCompression(t *testing.T) {
+	_, err := NewReader(strings.NewReader("{}"))
+
+	if err == nil || !strings.Contains(err.Error(), "unknown trace compression") {
+		t.Errorf("expected an unknown compression error, got %v", err)
+	}
+}
+
+func readAll(t *testing.T, r *Reader) []string {
+	calls := []string{}
+
+	for {
+		call, err := r.Next()
+
+		if err == io.EOF {
+			return calls
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		calls = append(calls, describe(call))
+	}
+}
+
+// a call as "number [@thread] function(name = value, ...) [= return] [flags n] [incomplete]", threads other than the
+// first being shown
+func describe(call *Call) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d ", call.Number)
+
+	if call.Thread != 0 {
+		fmt.Fprintf(&b, "@%d ", call.Thread)
+	}
+
+	args := make([]string, len(call.Args))
+
+	for i, arg := range call.Args {
+		args[i] = arg.Name + " = " + arg.Value.String()
+	}
+
+	fmt.Fprintf(&b, "%s(%s)", call.Function, strings.Join(args, ", "))
+
+	if call.Return != nil {
+		fmt.Fprintf(&b, " = %s", call.Return)
+	}
+
+	if call.Flags != 0 {
+		fmt.Fprintf(&b, " flags %d", call.Flags)
+	}
+
+	if call.Incomplete {
+		b.WriteString(" incomplete")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,433 @@
+//go:build ignore
+// +build ignore
+
+// Writes the .trace fixtures of the tracefile tests. Run from the tracefile directory with `go run testdata/gen.go`.
+//
+// snappy.trace and gzip.trace hold the same version 6 trace, compressed each way:
+//
+//	frame 0: 0 glClearColor, 1 glEnable(GL_BLEND), 2 glEnable(GL_DEPTH_TEST), 3 glBufferData(data = blob(16)),
+//	         4 glClear(mask = GL_COLOR_BUFFER_BIT | GL_DEPTH_BUFFER_BIT), 5 glXSwapBuffers
+//	frame 1: 6 glMultiDrawElements(indices = {blob(6), blob(6)}), 7 glFlush, flagged as ending the frame
+//	frame 2: 9 and 8 glGetError on threads 2 and 1, returning in that order, 11 glDrawArrays, and 10 glFinish, which
+//	         never returned
+//
+// truncated.trace is the same trace cut off partway through the event returning from call 6, followed by half a snappy
+// chunk, and v2.trace a version 2 trace, from before enum signatures held more than one name and calls recorded their
+// thread
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"github.com/golang/snappy"
+	"io/ioutil"
+	"log"
+	"math"
+)
+
+const (
+	eventEnter = 0
+	eventLeave = 1
+
+	detailEnd    = 0
+	detailArg    = 1
+	detailReturn = 2
+	detailFlags  = 5
+
+	typeSInt    = 3
+	typeUInt    = 4
+	typeFloat   = 5
+	typeBlob    = 8
+	typeEnum    = 9
+	typeBitmask = 10
+	typeArray   = 11
+	typeOpaque  = 13
+
+	flagEndFrame = 1 << 5
+)
+
+type writer struct {
+	bytes.Buffer
+	version uint64
+
+	// the signatures already written, which later uses refer to by id alone
+	functions map[string]uint64
+	enums     map[string]uint64
+	bitmasks  map[string]uint64
+}
+
+func newWriter(version uint64) *writer {
+	return &writer{
+		version:   version,
+		functions: map[string]uint64{},
+		enums:     map[string]uint64{},
+		bitmasks:  map[string]uint64{},
+	}
+}
+
+func (w *writer) uint(value uint64) {
+	for value >= 0x80 {
+		w.WriteByte(byte(value) | 0x80)
+		value >>= 7
+	}
+
+	w.WriteByte(byte(value))
+}
+
+func (w *writer) string(s string) {
+	w.uint(uint64(len(s)))
+	w.WriteString(s)
+}
+
+func (w *writer) sint(value int64) {
+	if value < 0 {
+		w.WriteByte(typeSInt)
+		w.uint(uint64(-value))
+		return
+	}
+
+	w.WriteByte(typeUInt)
+	w.uint(uint64(value))
+}
+
+func (w *writer) header(properties ...string) {
+	w.uint(w.version)
+
+	if w.version < 6 {
+		return
+	}
+
+	w.uint(w.version)
+
+	for _, s := range properties {
+		w.string(s)
+	}
+
+	w.string("")
+}
+
+func (w *writer) enter(thread uint64, function string, args ...string) {
+	w.WriteByte(eventEnter)
+
+	if w.version >= 4 {
+		w.uint(thread)
+	}
+
+	id, ok := w.functions[function]
+
+	if ok {
+		w.uint(id)
+		return
+	}
+
+	id = uint64(len(w.functions))
+	w.functions[function] = id
+
+	w.uint(id)
+	w.string(function)
+	w.uint(uint64(len(args)))
+
+	for _, arg := range args {
+		w.string(arg)
+	}
+}
+
+func (w *writer) arg(index uint64, value func()) {
+	w.WriteByte(detailArg)
+	w.uint(index)
+	value()
+}
+
+func (w *writer) returns(value func()) {
+	w.WriteByte(detailReturn)
+	value()
+}
+
+func (w *writer) end() {
+	w.WriteByte(detailEnd)
+}
+
+func (w *writer) leave(number uint64) {
+	w.WriteByte(eventLeave)
+	w.uint(number)
+}
+
+func (w *writer) uintValue(value uint64) func() {
+	return func() {
+		w.WriteByte(typeUInt)
+		w.uint(value)
+	}
+}
+
+func (w *writer) floatValue(value float32) func() {
+	return func() {
+		w.WriteByte(typeFloat)
+		binary.Write(w, binary.LittleEndian, math.Float32bits(value))
+	}
+}
+
+func (w *writer) pointerValue(value uint64) func() {
+	return func() {
+		w.WriteByte(typeOpaque)
+		w.uint(value)
+	}
+}
+
+func (w *writer) blobValue(data []byte) func() {
+	return func() {
+		w.WriteByte(typeBlob)
+		w.uint(uint64(len(data)))
+		w.Write(data)
+	}
+}
+
+func (w *writer) arrayValue(elements ...func()) func() {
+	return func() {
+		w.WriteByte(typeArray)
+		w.uint(uint64(len(elements)))
+
+		for _, element := range elements {
+			element()
+		}
+	}
+}
+
+// an enum whose signature is named by its first name, holding the names and values given, alternately
+func (w *writer) enumValue(value int64, names ...interface{}) func() {
+	return func() {
+		w.WriteByte(typeEnum)
+
+		key := names[0].(string)
+
+		if id, ok := w.enums[key]; ok {
+			w.uint(id)
+			w.sint(value)
+			return
+		}
+
+		id := uint64(len(w.enums))
+		w.enums[key] = id
+
+		w.uint(id)
+
+		if w.version >= 3 {
+			w.uint(uint64(len(names) / 2))
+		}
+
+		for i := 0; i < len(names); i += 2 {
+			w.string(names[i].(string))
+			w.sint(names[i+1].(int64))
+		}
+
+		w.sint(value)
+	}
+}
+
+func (w *writer) bitmaskValue(value uint64, names ...interface{}) func() {
+	return func() {
+		w.WriteByte(typeBitmask)
+
+		key := names[0].(string)
+
+		if id, ok := w.bitmasks[key]; ok {
+			w.uint(id)
+			w.uint(value)
+			return
+		}
+
+		id := uint64(len(w.bitmasks))
+		w.bitmasks[key] = id
+
+		w.uint(id)
+		w.uint(uint64(len(names) / 2))
+
+		for i := 0; i < len(names); i += 2 {
+			w.string(names[i].(string))
+			w.uint(names[i+1].(uint64))
+		}
+
+		w.uint(value)
+	}
+}
+
+// The trace, and where it is cut off in truncated.trace
+func version6() ([]byte, int) {
+	w := newWriter(6)
+
+	w.header("process.name", "triangle", "gl.vendor", "Mesa")
+
+	w.enter(0, "glClearColor", "red", "green", "blue", "alpha")
+	w.arg(0, w.floatValue(0.25))
+	w.arg(1, w.floatValue(0.5))
+	w.arg(2, w.floatValue(0.75))
+	w.arg(3, w.floatValue(1))
+	w.end()
+	w.leave(0)
+	w.end()
+
+	capabilities := []interface{}{"GL_BLEND", int64(0x0BE2), "GL_DEPTH_TEST", int64(0x0B71)}
+
+	w.enter(0, "glEnable", "cap")
+	w.arg(0, w.enumValue(0x0BE2, capabilities...))
+	w.end()
+	w.leave(1)
+	w.end()
+
+	w.enter(0, "glEnable", "cap")
+	w.arg(0, w.enumValue(0x0B71, capabilities...))
+	w.end()
+	w.leave(2)
+	w.end()
+
+	w.enter(0, "glBufferData", "target", "size", "data", "usage")
+	w.arg(0, w.enumValue(0x8892, "GL_ARRAY_BUFFER", int64(0x8892)))
+	w.arg(1, w.uintValue(16))
+	w.arg(2, w.blobValue([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}))
+	w.arg(3, w.enumValue(0x88E4, "GL_STATIC_DRAW", int64(0x88E4)))
+	w.end()
+	w.leave(3)
+	w.end()
+
+	w.enter(0, "glClear", "mask")
+	w.arg(0, w.bitmaskValue(0x4100, "GL_COLOR_BUFFER_BIT", uint64(0x4000), "GL_DEPTH_BUFFER_BIT", uint64(0x100)))
+	w.end()
+	w.leave(4)
+	w.end()
+
+	w.enter(0, "glXSwapBuffers", "dpy", "drawable")
+	w.arg(0, w.pointerValue(0x5581ac2f06b0))
+	w.arg(1, w.uintValue(62914562))
+	w.end()
+	w.leave(5)
+	w.end()
+
+	w.enter(0, "glMultiDrawElements", "mode", "count", "type", "indices", "drawcount")
+	w.arg(0, w.enumValue(4, "GL_TRIANGLES", int64(4)))
+	w.arg(1, w.arrayValue(w.uintValue(3), w.uintValue(3)))
+	w.arg(2, w.enumValue(0x1403, "GL_UNSIGNED_SHORT", int64(0x1403)))
+	w.arg(3, w.arrayValue(w.blobValue([]byte{0, 0, 1, 0, 2, 0}), w.blobValue([]byte{2, 0, 3, 0, 0, 0})))
+	w.arg(4, w.uintValue(2))
+	w.end()
+
+	cut := w.Len() + 1
+
+	w.leave(6)
+	w.end()
+
+	w.enter(0, "glFlush")
+	w.end()
+	w.leave(7)
+	w.WriteByte(detailFlags)
+	w.uint(flagEndFrame)
+	w.end()
+
+	noError := []interface{}{"GL_NO_ERROR", int64(0)}
+
+	w.enter(1, "glGetError")
+	w.end()
+	w.enter(2, "glGetError")
+	w.end()
+	w.leave(9)
+	w.returns(w.enumValue(0, noError...))
+	w.end()
+	w.leave(8)
+	w.returns(w.enumValue(0, noError...))
+	w.end()
+
+	w.enter(0, "glFinish")
+	w.end()
+
+	w.enter(0, "glDrawArrays", "mode", "first", "count")
+	w.arg(0, w.enumValue(4, "GL_TRIANGLES", int64(4)))
+	w.arg(1, w.uintValue(0))
+	w.arg(2, w.uintValue(3))
+	w.end()
+	w.leave(11)
+	w.end()
+
+	return w.Bytes(), cut
+}
+
+func version2() []byte {
+	w := newWriter(2)
+
+	w.header()
+
+	w.enter(0, "glEnable", "cap")
+	w.arg(0, w.enumValue(0x0BE2, "GL_BLEND", int64(0x0BE2)))
+	w.end()
+	w.leave(0)
+	w.end()
+
+	w.enter(0, "glEnable", "cap")
+	w.arg(0, w.enumValue(0x0BE2, "GL_BLEND", int64(0x0BE2)))
+	w.end()
+	w.leave(1)
+	w.end()
+
+	w.enter(0, "glXSwapBuffers", "dpy", "drawable")
+	w.arg(0, w.pointerValue(0x5581ac2f06b0))
+	w.arg(1, w.uintValue(62914562))
+	w.end()
+	w.leave(2)
+	w.end()
+
+	return w.Bytes()
+}
+
+// apitrace's snappy container: the "at" magic, then chunks of a little endian length and a snappy block
+func snappyTrace(data []byte, chunkSize int) []byte {
+	var b bytes.Buffer
+
+	b.WriteString("at")
+
+	for len(data) > 0 {
+		n := chunkSize
+
+		if n > len(data) {
+			n = len(data)
+		}
+
+		block := snappy.Encode(nil, data[:n])
+
+		binary.Write(&b, binary.LittleEndian, uint32(len(block)))
+		b.Write(block)
+
+		data = data[n:]
+	}
+
+	return b.Bytes()
+}
+
+func gzipTrace(data []byte) []byte {
+	var b bytes.Buffer
+
+	w := gzip.NewWriter(&b)
+	w.Write(data)
+	w.Close()
+
+	return b.Bytes()
+}
+
+func main() {
+	trace, cut := version6()
+
+	// the chunks up to the cut, then the first half of the chunk after it, without the magic
+	truncated := snappyTrace(trace[:cut], 64)
+	rest := snappyTrace(trace[cut:], 64)[2:]
+	truncated = append(truncated, rest[:4+binary.LittleEndian.Uint32(rest)/2]...)
+
+	fixtures := map[string][]byte{
+		"testdata/snappy.trace":    snappyTrace(trace, 1<<20),
+		"testdata/gzip.trace":      gzipTrace(trace),
+		"testdata/truncated.trace": truncated,
+		"testdata/v2.trace":        snappyTrace(version2(), 1<<20),
+	}
+
+	for path, data := range fixtures {
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package tracefile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Call flags set by the tracer; only the ones the server uses are listed
+const (
+	FlagEndFrame   = 1 << 5
+	FlagIncomplete = 1 << 6
+)
+
+type Call struct {
+	Number    uint64
+	Thread    uint64
+	Function  string
+	Args      []*Arg
+	Return    Value
+	Flags     uint64
+	Backtrace []*StackFrame
+
+	// the call had not returned when the trace ended
+	Incomplete bool
+}
+
+// An argument's value is nil when the tracer never wrote it
+type Arg struct {
+	Name  string
+	Value Value
+}
+
+type StackFrame struct {
+	Module   string
+	Function string
+	Filename string
+	Line     uint64
+	Offset   uint64
+}
+
+// A value read from a trace. String renders it the way `apitrace dump` does
+type Value interface {
+	String() string
+}
+
+type Null struct{}
+
+type Bool bool
+
+type SInt int64
+
+type UInt uint64
+
+type Float float32
+
+type Double float64
+
+type String string
+
+type WString string
+
+// Data is nil when the Reader skips blobs
+type Blob struct {
+	Size uint64
+	Data []byte
+}
+
+type EnumSig struct {
+	ID     uint64
+	Values []EnumName
+}
+
+type EnumName struct {
+	Name  string
+	Value int64
+}
+
+type Enum struct {
+	Sig   *EnumSig
+	Value int64
+}
+
+type BitmaskSig struct {
+	ID    uint64
+	Flags []BitmaskFlag
+}
+
+type BitmaskFlag struct {
+	Name  string
+	Value uint64
+}
+
+type Bitmask struct {
+	Sig   *BitmaskSig
+	Value uint64
+}
+
+type Array []Value
+
+type StructSig struct {
+	ID          uint64
+	Name        string
+	MemberNames []string
+}
+
+type Struct struct {
+	Sig     *StructSig
+	Members []Value
+}
+
+type Pointer uint64
+
+// A value written both for people to read and for the replayer, such as a shader handle and its source
+type Repr struct {
+	Human   Value
+	Machine Value
+}
+
+func (Null) String() string {
+	return "NULL"
+}
+
+func (b Bool) String() string {
+	return strconv.FormatBool(bool(b))
+}
+
+func (i SInt) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (u UInt) String() string {
+	return strconv.FormatUint(uint64(u), 10)
+}
+
+func (f Float) String() string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+}
+
+func (d Double) String() string {
+	return strconv.FormatFloat(float64(d), 'g', -1, 64)
+}
+
+func (s String) String() string {
+	return quote(string(s))
+}
+
+func (s WString) String() string {
+	return "L" + quote(string(s))
+}
+
+func (b *Blob) String() string {
+	return fmt.Sprintf("blob(%d)", b.Size)
+}
+
+// The symbol for the enum's value, or empty when the signature has no name for it
+func (e *Enum) Name() string {
+	for _, value := range e.Sig.Values {
+		if value.Value == e.Value {
+			return value.Name
+		}
+	}
+
+	return ""
+}
+
+func (e *Enum) String() string {
+	if name := e.Name(); len(name) > 0 {
+		return name
+	}
+
+	return strconv.FormatInt(e.Value, 10)
+}
+
+// The names of the flags set in the bitmask, and whatever bits no flag covers
+func (b *Bitmask) Names() ([]string, uint64) {
+	var names []string
+
+	remaining := b.Value
+
+	for _, flag := range b.Sig.Flags {
+		if flag.Value == 0 {
+			if b.Value == 0 {
+				names = append(names, flag.Name)
+			}
+
+			continue
+		}
+
+		if remaining&flag.Value == flag.Value {
+			names = append(names, flag.Name)
+			remaining &^= flag.Value
+		}
+	}
+
+	return names, remaining
+}
+
+func (b *Bitmask) String() string {
+	names, remaining := b.Names()
+
+	if remaining != 0 || len(names) == 0 {
+		names = append(names, fmt.Sprintf("0x%x", remaining))
+	}
+
+	return strings.Join(names, " | ")
+}
+
+// single element arrays are how apitrace records pointers to one value
+func (a Array) String() string {
+	if len(a) == 1 {
+		return "&" + a[0].String()
+	}
+
+	elements := make([]string, len(a))
+
+	for i, element := range a {
+		elements[i] = element.String()
+	}
+
+	return "{" + strings.Join(elements, ", ") + "}"
+}
+
+func (s *Struct) String() string {
+	members := make([]string, len(s.Members))
+
+	for i, member := range s.Members {
+		members[i] = fmt.Sprintf("%s = %s", s.Sig.MemberNames[i], member.String())
+	}
+
+	return "{" + strings.Join(members, ", ") + "}"
+}
+
+func (p Pointer) String() string {
+	if p == 0 {
+		return "NULL"
+	}
+
+	return fmt.Sprintf("0x%x", uint64(p))
+}
+
+func (r *Repr) String() string {
+	return r.Human.String()
+}
+
+func quote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+
+	return `"` + s + `"`
+}